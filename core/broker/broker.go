@@ -0,0 +1,38 @@
+// Package broker is the messaging contract shared by all services. It
+// deliberately exposes only what producers and consumers need, so the
+// underlying system (NATS, Kafka, ...) can be swapped per environment.
+package broker
+
+import (
+	"context"
+	"time"
+)
+
+// Message is one event on its way through the broker.
+type Message struct {
+	// Topic routes the message; by convention "<service>.events".
+	Topic string
+	// Key groups related messages (usually the aggregate ID) so consumers
+	// that care about ordering can partition on it.
+	Key string
+	// Payload is the JSON-encoded event body.
+	Payload []byte
+	// Headers carry metadata such as the event name and trace context.
+	Headers map[string]string
+	// OccurredAt is when the producing domain operation happened.
+	OccurredAt time.Time
+}
+
+// Publisher delivers messages to the broker.
+type Publisher interface {
+	Publish(ctx context.Context, msg Message) error
+}
+
+// Handler consumes one message. Returning an error makes the subscription
+// redeliver according to its policy.
+type Handler func(ctx context.Context, msg Message) error
+
+// Subscriber attaches handlers to topics.
+type Subscriber interface {
+	Subscribe(ctx context.Context, topic, group string, handler Handler) error
+}
@@ -0,0 +1,145 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/db"
+)
+
+// Outbox implements the transactional outbox pattern: producers insert
+// events into an outbox table (ideally inside the same transaction as the
+// domain write) and a relay moves them to the broker, so an event is never
+// published for a rolled-back write and never lost for a committed one.
+type Outbox struct {
+	pool *pgxpool.Pool
+}
+
+// NewOutbox builds an outbox over the service's database pool. The
+// outbox_events table must exist (see the service's migrations).
+func NewOutbox(pool *pgxpool.Pool) *Outbox {
+	return &Outbox{pool: pool}
+}
+
+// Publish stores the message in the outbox. A transaction bound to the
+// context by db.TxManager is joined, so the event commits with the
+// domain write; otherwise the pool is used directly. Use StoreTx when
+// holding a transaction by hand.
+func (o *Outbox) Publish(ctx context.Context, msg Message) error {
+	return o.store(ctx, db.QuerierFrom(ctx, o.pool), msg)
+}
+
+// StoreTx stores the message within the caller's transaction so the event
+// commits or rolls back together with the domain write.
+func (o *Outbox) StoreTx(ctx context.Context, tx pgx.Tx, msg Message) error {
+	return o.store(ctx, tx, msg)
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func (o *Outbox) store(ctx context.Context, q execer, msg Message) error {
+	const sql = `
+		INSERT INTO outbox_events (id, topic, key, payload, headers, occurred_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, now())`
+	_, err := q.Exec(ctx, sql, uuid.New(), msg.Topic, msg.Key, msg.Payload, msg.Headers, msg.OccurredAt)
+	if err != nil {
+		return fmt.Errorf("insert outbox_events: %w", err)
+	}
+	return nil
+}
+
+// Relay drains the outbox into the real broker.
+type Relay struct {
+	pool      *pgxpool.Pool
+	publisher Publisher
+	log       *slog.Logger
+
+	// Interval between polls; BatchSize rows are claimed per poll.
+	Interval  time.Duration
+	BatchSize int
+}
+
+// NewRelay builds a relay that moves stored events to the publisher.
+func NewRelay(pool *pgxpool.Pool, publisher Publisher, log *slog.Logger) *Relay {
+	return &Relay{
+		pool:      pool,
+		publisher: publisher,
+		log:       log,
+		Interval:  time.Second,
+		BatchSize: 100,
+	}
+}
+
+// Run polls until the context is cancelled.
+func (r *Relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.drainOnce(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				r.log.Error("outbox relay: drain failed", "error", err)
+			}
+		}
+	}
+}
+
+func (r *Relay) drainOnce(ctx context.Context) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, topic, key, payload, headers, occurred_at
+		FROM outbox_events
+		WHERE published_at IS NULL
+		ORDER BY created_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED`, r.BatchSize)
+	if err != nil {
+		return fmt.Errorf("select outbox_events: %w", err)
+	}
+	type pending struct {
+		id  uuid.UUID
+		msg Message
+	}
+	var batch []pending
+	for rows.Next() {
+		var p pending
+		if err := rows.Scan(&p.id, &p.msg.Topic, &p.msg.Key, &p.msg.Payload, &p.msg.Headers, &p.msg.OccurredAt); err != nil {
+			rows.Close()
+			return fmt.Errorf("scan outbox_events: %w", err)
+		}
+		batch = append(batch, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, p := range batch {
+		if err := r.publisher.Publish(ctx, p.msg); err != nil {
+			// Stop the batch; unpublished rows stay locked until the
+			// rollback and are retried next tick.
+			return fmt.Errorf("publish %s: %w", p.msg.Topic, err)
+		}
+		if _, err := tx.Exec(ctx, "UPDATE outbox_events SET published_at = now() WHERE id = $1", p.id); err != nil {
+			return fmt.Errorf("mark outbox_events published: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,91 @@
+// Package locale carries the caller's preferred language from the HTTP
+// edge through contexts, gRPC metadata, and outbound calls, so every
+// layer that renders text (error messages, SMS templates) agrees on the
+// language without reparsing headers.
+package locale
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Supported locales; Default is what an absent or unknown
+// Accept-Language resolves to.
+const (
+	Farsi   = "fa"
+	English = "en"
+	Default = Farsi
+)
+
+// MetadataKey carries the resolved locale across gRPC hops.
+const MetadataKey = "x-locale"
+
+type localeKey struct{}
+
+// WithLocale binds a locale to the context.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext returns the locale bound to ctx, or Default.
+func FromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeKey{}).(string); ok {
+		return locale
+	}
+	return Default
+}
+
+// Parse resolves an Accept-Language header to a supported locale. Only
+// the primary subtag matters ("fa-IR" is Farsi); entries are tried in
+// header order, which approximates quality order closely enough for a
+// two-language product.
+func Parse(header string) string {
+	for _, part := range strings.Split(header, ",") {
+		lang, _, _ := strings.Cut(strings.TrimSpace(part), ";")
+		primary, _, _ := strings.Cut(lang, "-")
+		switch strings.ToLower(primary) {
+		case Farsi:
+			return Farsi
+		case English:
+			return English
+		}
+	}
+	return Default
+}
+
+// Middleware resolves Accept-Language into the request context for
+// HTTP handlers behind it.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := WithLocale(r.Context(), Parse(r.Header.Get("Accept-Language")))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// ToOutgoing forwards the context's locale as gRPC metadata; call it
+// (or let a client interceptor call it) before outbound RPCs.
+func ToOutgoing(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, FromContext(ctx))
+}
+
+// FromIncoming binds the locale a peer forwarded in gRPC metadata,
+// for use in server interceptors.
+func FromIncoming(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 {
+			return WithLocale(ctx, Parse(values[0]))
+		}
+	}
+	return ctx
+}
+
+// ForwardHeader sets Accept-Language on an outbound HTTP request from
+// its context; register it on shared clients with
+// common.WithRequestHook(locale.ForwardHeader).
+func ForwardHeader(req *http.Request) error {
+	req.Header.Set("Accept-Language", FromContext(req.Context()))
+	return nil
+}
@@ -0,0 +1,226 @@
+// Package metric holds the service-level-objective layer: it turns raw
+// request outcomes into burn-rate and error-budget metrics, so alerts
+// page on "we are spending budget 10x too fast", not on raw error
+// counts that fire during every deploy.
+package metric
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Objective is one route's SLO. A request is "good" when it neither
+// fails nor exceeds the latency threshold; the objective is the target
+// fraction of good requests over the window.
+type Objective struct {
+	// Route labels the metrics and, for the HTTP middleware, matches
+	// request paths by prefix.
+	Route string
+	// Target fraction of good requests, e.g. 0.999.
+	Target float64
+	// LatencyThreshold marks slow-but-successful requests as bad;
+	// zero disables the latency part of the objective.
+	LatencyThreshold time.Duration
+	// Window is the budget period; zero defaults to 24h. Longer
+	// windows need proportionally more in-memory buckets, one per
+	// minute.
+	Window time.Duration
+}
+
+const defaultSLOWindow = 24 * time.Hour
+
+// burnWindows are the lookback spans burn rate is reported over; the
+// short one catches fast outages, the long one slow leaks — the usual
+// multi-window alert pairs both.
+var burnWindows = []time.Duration{5 * time.Minute, time.Hour}
+
+var (
+	sloRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "infera",
+		Subsystem: "slo",
+		Name:      "requests_total",
+		Help:      "Requests per route, split into good and bad per the objective.",
+	}, []string{"route", "verdict"})
+
+	sloBurnRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "infera",
+		Subsystem: "slo",
+		Name:      "burn_rate",
+		Help:      "Error-budget burn rate over the lookback window; 1 means burning exactly at budget.",
+	}, []string{"route", "window"})
+
+	sloBudgetRemaining = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "infera",
+		Subsystem: "slo",
+		Name:      "error_budget_remaining",
+		Help:      "Fraction of the window's error budget left; negative means the SLO is blown.",
+	}, []string{"route"})
+)
+
+// Tracker accumulates per-minute good/bad counts per objective and
+// republishes the derived gauges from Run.
+type Tracker struct {
+	objectives []Objective
+	log        *slog.Logger
+
+	mu     sync.Mutex
+	routes map[string]*routeWindow
+}
+
+// routeWindow is a ring of per-minute buckets covering the objective's
+// window.
+type routeWindow struct {
+	objective Objective
+	buckets   []bucket
+	head      int
+	headTime  time.Time
+}
+
+type bucket struct {
+	good int64
+	bad  int64
+}
+
+// NewTracker builds a tracker for the configured objectives.
+func NewTracker(objectives []Objective, log *slog.Logger) *Tracker {
+	t := &Tracker{objectives: objectives, log: log, routes: make(map[string]*routeWindow, len(objectives))}
+	for _, o := range objectives {
+		if o.Window <= 0 {
+			o.Window = defaultSLOWindow
+		}
+		t.routes[o.Route] = &routeWindow{
+			objective: o,
+			buckets:   make([]bucket, int(o.Window/time.Minute)),
+			headTime:  time.Now().Truncate(time.Minute),
+		}
+	}
+	return t
+}
+
+// Observe records one request against its route's objective. Unknown
+// routes are ignored — only what has an objective is tracked, which
+// also keeps label cardinality fixed.
+func (t *Tracker) Observe(route string, latency time.Duration, failed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	w, ok := t.routes[route]
+	if !ok {
+		return
+	}
+	w.advance(time.Now())
+	bad := failed || (w.objective.LatencyThreshold > 0 && latency > w.objective.LatencyThreshold)
+	if bad {
+		w.buckets[w.head].bad++
+		sloRequests.WithLabelValues(route, "bad").Inc()
+	} else {
+		w.buckets[w.head].good++
+		sloRequests.WithLabelValues(route, "good").Inc()
+	}
+}
+
+// advance rotates the ring up to now, zeroing buckets that fall out of
+// the window.
+func (w *routeWindow) advance(now time.Time) {
+	now = now.Truncate(time.Minute)
+	for w.headTime.Before(now) {
+		w.head = (w.head + 1) % len(w.buckets)
+		w.buckets[w.head] = bucket{}
+		w.headTime = w.headTime.Add(time.Minute)
+	}
+}
+
+// Run republishes the derived gauges once a minute until ctx ends; wire
+// it like the other background workers.
+func (t *Tracker) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.publish()
+		}
+	}
+}
+
+func (t *Tracker) publish() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	for route, w := range t.routes {
+		w.advance(now)
+		budget := 1 - w.objective.Target
+		if budget <= 0 {
+			continue
+		}
+		for _, span := range burnWindows {
+			good, bad := w.totals(span)
+			rate := 0.0
+			if total := good + bad; total > 0 {
+				rate = (float64(bad) / float64(total)) / budget
+			}
+			sloBurnRate.WithLabelValues(route, span.String()).Set(rate)
+		}
+		good, bad := w.totals(w.objective.Window)
+		remaining := 1.0
+		if total := good + bad; total > 0 {
+			allowed := budget * float64(total)
+			remaining = (allowed - float64(bad)) / allowed
+		}
+		sloBudgetRemaining.WithLabelValues(route).Set(remaining)
+	}
+}
+
+// totals sums the most recent span of buckets.
+func (w *routeWindow) totals(span time.Duration) (good, bad int64) {
+	n := int(span / time.Minute)
+	if n > len(w.buckets) {
+		n = len(w.buckets)
+	}
+	for i := 0; i < n; i++ {
+		b := w.buckets[(w.head-i+len(w.buckets))%len(w.buckets)]
+		good += b.good
+		bad += b.bad
+	}
+	return good, bad
+}
+
+// Middleware observes HTTP requests against the first objective whose
+// Route is a prefix of the path; 5xx responses count as failures.
+func (t *Tracker) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := ""
+		for _, o := range t.objectives {
+			if strings.HasPrefix(r.URL.Path, o.Route) {
+				route = o.Route
+				break
+			}
+		}
+		if route == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(recorder, r)
+		t.Observe(route, time.Since(start), recorder.status >= 500)
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
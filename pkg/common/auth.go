@@ -0,0 +1,84 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// WithBasicAuth sends the credentials on every request.
+func WithBasicAuth(username, password string) HTTPClientOption {
+	return WithRequestHook(func(req *http.Request) error {
+		req.SetBasicAuth(username, password)
+		return nil
+	})
+}
+
+// WithBearer sends a static bearer token on every request. For tokens
+// that expire, use WithTokenSource instead.
+func WithBearer(token string) HTTPClientOption {
+	return WithRequestHook(func(req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// TokenSource yields a valid access token for an outgoing request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// WithTokenSource attaches a bearer token from src to every request,
+// so integrations against token-issuing providers (Shahkar OAuth) stop
+// writing their own token plumbing. A source error fails the call.
+func WithTokenSource(src TokenSource) HTTPClientOption {
+	return WithRequestHook(func(req *http.Request) error {
+		token, err := src.Token(req.Context())
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// Token is a fetched access token and its expiry.
+type Token struct {
+	Value     string
+	ExpiresAt time.Time
+}
+
+// tokenRefreshMargin is how early a cached token is refreshed, so a
+// token never expires mid-request.
+const tokenRefreshMargin = 30 * time.Second
+
+// NewCachedTokenSource caches tokens from fetch and refreshes them
+// proactively, tokenRefreshMargin before expiry. Concurrent callers
+// share one fetch; a token without an expiry is fetched once and kept.
+func NewCachedTokenSource(fetch func(ctx context.Context) (Token, error)) TokenSource {
+	return &cachedTokenSource{fetch: fetch}
+}
+
+type cachedTokenSource struct {
+	fetch func(ctx context.Context) (Token, error)
+
+	mu      sync.Mutex
+	current Token
+	have    bool
+}
+
+func (s *cachedTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.have && (s.current.ExpiresAt.IsZero() || time.Until(s.current.ExpiresAt) > tokenRefreshMargin) {
+		return s.current.Value, nil
+	}
+	token, err := s.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.current = token
+	s.have = true
+	return token.Value, nil
+}
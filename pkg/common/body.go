@@ -0,0 +1,59 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrResponseTooLarge is returned by body reads once a response exceeds
+// the limit set with WithMaxResponseBytes.
+var ErrResponseTooLarge = errors.New("common: response body exceeds configured limit")
+
+// WithMaxResponseBytes caps every response body at n bytes. Reads past
+// the cap fail with ErrResponseTooLarge, so a misbehaving provider
+// cannot make a service buffer an unbounded payload.
+func WithMaxResponseBytes(n int64) HTTPClientOption {
+	return func(c *HTTPClient) { c.maxBody = n }
+}
+
+// limitedBody enforces the response size cap. It does not truncate:
+// crossing the limit surfaces as a read error, because a silently
+// shortened payload is worse than a failed call.
+type limitedBody struct {
+	rc        io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.rc.Read(p)
+	b.remaining -= int64(n)
+	if b.remaining < 0 {
+		return n, ErrResponseTooLarge
+	}
+	return n, err
+}
+
+func (b *limitedBody) Close() error { return b.rc.Close() }
+
+// Download performs a GET and streams the response body into w, so
+// large payloads (documents, export archives) are never buffered in
+// memory. It returns the number of bytes written; non-2xx statuses
+// return an error carrying a short body excerpt for diagnostics.
+func (c *HTTPClient) Download(ctx context.Context, url string, w io.Writer, opts ...RequestOption) (int64, error) {
+	resp, err := c.Get(ctx, url, opts...)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return 0, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, excerpt)
+	}
+	written, err := io.Copy(w, resp.Body)
+	if err != nil {
+		return written, fmt.Errorf("download %s: %w", url, err)
+	}
+	return written, nil
+}
@@ -0,0 +1,148 @@
+package common
+
+import (
+	"errors"
+	"expvar"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without sending the request while the
+// target host's breaker is open.
+var ErrCircuitOpen = errors.New("common: circuit breaker open")
+
+// Breaker states, exported as the <host>.state gauge.
+const (
+	breakerClosed int64 = iota
+	breakerHalfOpen
+	breakerOpen
+)
+
+// httpBreakerMetrics exposes per-host breaker state under the
+// "common_http_breaker" expvar map: <host>.state (0 closed, 1
+// half-open, 2 open) and <host>.rejected.
+var httpBreakerMetrics = expvar.NewMap("common_http_breaker")
+
+// BreakerConfig tunes the HTTPClient circuit breaker.
+type BreakerConfig struct {
+	// FailureThreshold is how many consecutive failures open the
+	// breaker.
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before letting one
+	// half-open probe through.
+	OpenTimeout time.Duration
+}
+
+// DefaultBreakerConfig is used for zero fields.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{FailureThreshold: 5, OpenTimeout: 30 * time.Second}
+}
+
+// WithCircuitBreaker makes the client fail fast per host: after
+// consecutive failures requests to that host return ErrCircuitOpen
+// immediately instead of tying up goroutines until timeout, with a
+// single periodic probe to detect recovery.
+func WithCircuitBreaker(cfg BreakerConfig) HTTPClientOption {
+	defaults := DefaultBreakerConfig()
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaults.FailureThreshold
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = defaults.OpenTimeout
+	}
+	return func(c *HTTPClient) {
+		c.breakers = &breakerSet{cfg: cfg, hosts: make(map[string]*hostBreaker)}
+	}
+}
+
+// breakerSet holds one breaker per target host, created on first use.
+type breakerSet struct {
+	cfg BreakerConfig
+
+	mu    sync.Mutex
+	hosts map[string]*hostBreaker
+}
+
+func (s *breakerSet) forURL(rawURL string) *hostBreaker {
+	host := rawURL
+	if u, err := url.Parse(rawURL); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.hosts[host]
+	if !ok {
+		gauge := new(expvar.Int)
+		httpBreakerMetrics.Set(host+".state", gauge)
+		b = &hostBreaker{cfg: s.cfg, host: host, stateGauge: gauge}
+		s.hosts[host] = b
+	}
+	return b
+}
+
+// hostBreaker is one host's breaker: closed until FailureThreshold
+// consecutive failures, then open for OpenTimeout, then half-open with
+// a single probe.
+type hostBreaker struct {
+	cfg  BreakerConfig
+	host string
+
+	mu       sync.Mutex
+	state    int64
+	failures int
+	openedAt time.Time
+	probing  bool
+
+	stateGauge *expvar.Int
+}
+
+func (b *hostBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerClosed:
+		return nil
+	case breakerHalfOpen:
+		if b.probing {
+			b.reject()
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default: // open
+		if time.Since(b.openedAt) < b.cfg.OpenTimeout {
+			b.reject()
+			return ErrCircuitOpen
+		}
+		b.setState(breakerHalfOpen)
+		b.probing = true
+		return nil
+	}
+}
+
+func (b *hostBreaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if !failed {
+		b.failures = 0
+		b.setState(breakerClosed)
+		return
+	}
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.openedAt = time.Now()
+		b.setState(breakerOpen)
+	}
+}
+
+// reject and setState must be called with the mutex held.
+func (b *hostBreaker) reject() {
+	httpBreakerMetrics.Add(b.host+".rejected", 1)
+}
+
+func (b *hostBreaker) setState(state int64) {
+	b.state = state
+	b.stateGauge.Set(state)
+}
@@ -0,0 +1,153 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrDraining is what new work is refused with once draining started.
+var ErrDraining = errors.New("common: server is draining")
+
+// drainPollInterval is how often Drain re-checks the in-flight count.
+const drainPollInterval = 100 * time.Millisecond
+
+// Drainer coordinates graceful shutdown for rolling deploys: once
+// draining, readiness fails (so load balancers stop routing here), new
+// gRPC calls are refused, and Drain blocks until in-flight requests and
+// registered worker queues finish. Wire its Handler as the preStop
+// hook's target and call Drain before closing the listeners.
+type Drainer struct {
+	draining atomic.Bool
+	inflight atomic.Int64
+
+	mu      sync.Mutex
+	waiters map[string]func(ctx context.Context) error
+}
+
+// NewDrainer builds an idle drainer.
+func NewDrainer() *Drainer {
+	return &Drainer{waiters: make(map[string]func(ctx context.Context) error)}
+}
+
+// Draining reports whether shutdown has been requested.
+func (d *Drainer) Draining() bool { return d.draining.Load() }
+
+// RegisterWait adds a component Drain must wait for — typically a
+// worker whose queue should empty; the function returns once it has.
+func (d *Drainer) RegisterWait(name string, wait func(ctx context.Context) error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.waiters[name] = wait
+}
+
+// Check is the readiness hook: register it so /readiness flips to 503
+// the moment draining starts.
+func (d *Drainer) Check(context.Context) error {
+	if d.Draining() {
+		return ErrDraining
+	}
+	return nil
+}
+
+// Middleware tracks in-flight HTTP requests and refuses new ones while
+// draining. Health endpoints should be mounted outside it so the
+// kubelet can still probe a draining pod.
+func (d *Drainer) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if d.Draining() {
+			WriteProblem(w, r, Problem{
+				Status: http.StatusServiceUnavailable,
+				Detail: "server is draining",
+				Code:   "draining",
+			})
+			return
+		}
+		d.inflight.Add(1)
+		defer d.inflight.Add(-1)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// UnaryInterceptor refuses new unary calls with UNAVAILABLE while
+// draining; well-behaved clients retry against another replica.
+func (d *Drainer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if d.Draining() {
+			return nil, status.Error(codes.Unavailable, "server is draining")
+		}
+		d.inflight.Add(1)
+		defer d.inflight.Add(-1)
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is the stream-call counterpart of
+// UnaryInterceptor.
+func (d *Drainer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if d.Draining() {
+			return status.Error(codes.Unavailable, "server is draining")
+		}
+		d.inflight.Add(1)
+		defer d.inflight.Add(-1)
+		return handler(srv, ss)
+	}
+}
+
+// Drain flips to draining and blocks until in-flight requests hit zero
+// and every registered waiter returns, or ctx expires — bound it with
+// the deployment's termination grace period.
+func (d *Drainer) Drain(ctx context.Context) error {
+	d.draining.Store(true)
+
+	for d.inflight.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("common: drain: %d requests still in flight: %w", d.inflight.Load(), ctx.Err())
+		case <-time.After(drainPollInterval):
+		}
+	}
+
+	d.mu.Lock()
+	waiters := make(map[string]func(ctx context.Context) error, len(d.waiters))
+	for name, wait := range d.waiters {
+		waiters[name] = wait
+	}
+	d.mu.Unlock()
+	for name, wait := range waiters {
+		if err := wait(ctx); err != nil {
+			return fmt.Errorf("common: drain: waiting for %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Handler serves the admin drain endpoint: POST starts draining and
+// blocks until Drain completes (or the request context ends), so a
+// preStop hook can simply curl it and terminate when it returns.
+func (d *Drainer) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if err := d.Drain(r.Context()); err != nil {
+			WriteProblem(w, r, Problem{
+				Status: http.StatusServiceUnavailable,
+				Detail: err.Error(),
+				Code:   "drain_incomplete",
+			})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"sort"
+)
+
+// contentTypeForm is the body type of URL-encoded form posts.
+const contentTypeForm = "application/x-www-form-urlencoded"
+
+// WithFormBody sets the request body to the URL-encoded values, for
+// providers that only accept application/x-www-form-urlencoded. Pass an
+// empty contentType and nil body to the verb, e.g.
+// c.Post(ctx, url, "", nil, WithFormBody(values)).
+func WithFormBody(values url.Values) RequestOption {
+	encoded := values.Encode()
+	return func(req *http.Request) error {
+		setRequestBody(req, []byte(encoded), contentTypeForm)
+		return nil
+	}
+}
+
+// MultipartFile describes one file part of a multipart request.
+type MultipartFile struct {
+	Field    string
+	Filename string
+	// ContentType is optional; multipart defaults to octet-stream.
+	ContentType string
+	Content     io.Reader
+}
+
+// WithMultipartBody sets the request body to a multipart/form-data
+// payload of plain fields plus file parts, for providers that take
+// document uploads. The payload is assembled once up front, so it is
+// buffered in memory (uploads are size-capped by their callers) and
+// safe to retry. Use it like WithFormBody, with an empty contentType
+// and nil body on the verb.
+func WithMultipartBody(fields map[string]string, files ...MultipartFile) RequestOption {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	buildErr := buildMultipart(writer, fields, files)
+	if err := writer.Close(); err != nil && buildErr == nil {
+		buildErr = fmt.Errorf("close multipart body: %w", err)
+	}
+	payload := buf.Bytes()
+	contentType := writer.FormDataContentType()
+	return func(req *http.Request) error {
+		if buildErr != nil {
+			return buildErr
+		}
+		setRequestBody(req, payload, contentType)
+		return nil
+	}
+}
+
+func buildMultipart(writer *multipart.Writer, fields map[string]string, files []MultipartFile) error {
+	// Sorted for a stable part order; map iteration would reshuffle the
+	// payload between attempts.
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := writer.WriteField(name, fields[name]); err != nil {
+			return fmt.Errorf("write multipart field %s: %w", name, err)
+		}
+	}
+	for _, f := range files {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, f.Field, f.Filename))
+		if f.ContentType != "" {
+			header.Set("Content-Type", f.ContentType)
+		}
+		part, err := writer.CreatePart(header)
+		if err == nil {
+			_, err = io.Copy(part, f.Content)
+		}
+		if err != nil {
+			return fmt.Errorf("write multipart file %s: %w", f.Field, err)
+		}
+	}
+	return nil
+}
+
+// setRequestBody installs a replayable in-memory body on the request.
+func setRequestBody(req *http.Request, payload []byte, contentType string) {
+	req.Body = io.NopCloser(bytes.NewReader(payload))
+	req.ContentLength = int64(len(payload))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(payload)), nil
+	}
+	req.Header.Set("Content-Type", contentType)
+}
@@ -0,0 +1,89 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// CheckFunc probes one dependency; nil means ready.
+type CheckFunc func(ctx context.Context) error
+
+// checkTimeout bounds each probe so one hung dependency cannot stall
+// the whole readiness response.
+const checkTimeout = 3 * time.Second
+
+// Readiness aggregates named dependency checks behind one /readiness
+// endpoint, so orchestrators stop routing traffic to an instance whose
+// database or broker is gone rather than finding out via errors.
+type Readiness struct {
+	mu     sync.RWMutex
+	checks map[string]CheckFunc
+}
+
+// NewReadiness builds an empty registry.
+func NewReadiness() *Readiness {
+	return &Readiness{checks: make(map[string]CheckFunc)}
+}
+
+// Register adds a named check; registering the same name again
+// replaces it.
+func (r *Readiness) Register(name string, check CheckFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[name] = check
+}
+
+// Check runs every registered check and returns the failures by name.
+func (r *Readiness) Check(ctx context.Context) map[string]error {
+	r.mu.RLock()
+	checks := make(map[string]CheckFunc, len(r.checks))
+	for name, check := range r.checks {
+		checks[name] = check
+	}
+	r.mu.RUnlock()
+
+	failures := make(map[string]error)
+	for name, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		if err := check(checkCtx); err != nil {
+			failures[name] = err
+		}
+		cancel()
+	}
+	return failures
+}
+
+// Handler serves the registry as an HTTP endpoint: 200 with per-check
+// status when everything passes, 503 otherwise.
+func (r *Readiness) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		failures := r.Check(req.Context())
+
+		r.mu.RLock()
+		names := make([]string, 0, len(r.checks))
+		for name := range r.checks {
+			names = append(names, name)
+		}
+		r.mu.RUnlock()
+		sort.Strings(names)
+
+		status := make(map[string]string, len(names))
+		for _, name := range names {
+			if err, ok := failures[name]; ok {
+				status[name] = err.Error()
+			} else {
+				status[name] = "ok"
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if len(failures) > 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(status)
+	})
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// outboundHedges counts races where a hedge was actually fired: "win"
+// when a hedge answered first, "waste" when the primary did. A high
+// waste ratio means the delay is set too low for that provider.
+var outboundHedges = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "infera",
+	Subsystem: "outbound_http",
+	Name:      "hedges_total",
+	Help:      "Hedged request races by destination host and outcome.",
+}, []string{"host", "outcome"})
+
+// HedgeConfig tunes request hedging.
+type HedgeConfig struct {
+	// Delay is how long to wait for the primary before firing a hedge.
+	// Around the provider's p95 keeps amplification low while cutting
+	// tail latency.
+	Delay time.Duration
+	// MaxHedges caps the extra requests fired per attempt.
+	MaxHedges int
+}
+
+// DefaultHedgeConfig fires at most one hedge.
+var DefaultHedgeConfig = HedgeConfig{
+	Delay:     300 * time.Millisecond,
+	MaxHedges: 1,
+}
+
+// WithHedging races a second copy of slow requests against the first
+// and takes whichever answers first, trading a bounded amount of extra
+// load for tail latency on flaky providers. Only bodyless idempotent
+// methods (GET, HEAD) are hedged; everything else goes out once.
+// Zero-valued fields fall back to DefaultHedgeConfig.
+func WithHedging(cfg HedgeConfig) HTTPClientOption {
+	if cfg.Delay <= 0 {
+		cfg.Delay = DefaultHedgeConfig.Delay
+	}
+	if cfg.MaxHedges <= 0 {
+		cfg.MaxHedges = DefaultHedgeConfig.MaxHedges
+	}
+	return func(c *HTTPClient) { c.hedge = &cfg }
+}
+
+func hedgeableMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// cancelBody releases an attempt's context once its body is consumed;
+// canceling earlier would abort the read.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// doHedged runs one logical attempt as a race between a primary request
+// and up to MaxHedges delayed copies. Transport errors are left to the
+// retry loop above; a 5xx is kept as a fallback answer in case a
+// slower copy comes back healthy.
+func (c *HTTPClient) doHedged(ctx context.Context, method, rawURL, contentType string, opts ...RequestOption) (*http.Response, error) {
+	cfg := c.hedge
+	host := ""
+	if u, err := url.Parse(c.baseURL + rawURL); err == nil {
+		host = u.Host
+	}
+
+	type result struct {
+		resp  *http.Response
+		err   error
+		index int
+	}
+	total := 1 + cfg.MaxHedges
+	results := make(chan result, total)
+	cancels := make([]context.CancelFunc, 0, total)
+
+	launch := func() {
+		attemptCtx, cancel := context.WithCancel(ctx)
+		index := len(cancels)
+		cancels = append(cancels, cancel)
+		go func() {
+			resp, err := c.doOnce(attemptCtx, method, rawURL, contentType, nil, opts...)
+			results <- result{resp: resp, err: err, index: index}
+		}()
+	}
+
+	launched, received := 0, 0
+	launch()
+	launched++
+
+	// reap cancels the losers and drains whatever they still return.
+	reap := func(winner int) {
+		for i, cancel := range cancels {
+			if i != winner {
+				cancel()
+			}
+		}
+		go func(outstanding int) {
+			for i := 0; i < outstanding; i++ {
+				r := <-results
+				if r.resp != nil {
+					r.resp.Body.Close()
+				}
+			}
+		}(launched - received)
+	}
+
+	finish := func(r result) (*http.Response, error) {
+		if launched > 1 {
+			outcome := "waste"
+			if r.index > 0 {
+				outcome = "win"
+			}
+			outboundHedges.WithLabelValues(host, outcome).Inc()
+		}
+		reap(r.index)
+		r.resp.Body = &cancelBody{ReadCloser: r.resp.Body, cancel: cancels[r.index]}
+		return r.resp, nil
+	}
+
+	timer := time.NewTimer(cfg.Delay)
+	defer timer.Stop()
+
+	var fallback *result
+	var lastErr error
+	for {
+		select {
+		case r := <-results:
+			received++
+			switch {
+			case r.err == nil && !retryableStatus(r.resp.StatusCode):
+				if fallback != nil {
+					fallback.resp.Body.Close()
+					cancels[fallback.index]()
+				}
+				return finish(r)
+			case r.err == nil:
+				if fallback != nil {
+					fallback.resp.Body.Close()
+					cancels[fallback.index]()
+				}
+				r := r
+				fallback = &r
+			default:
+				lastErr = r.err
+			}
+			if received == launched && (launched == total || fallback == nil) {
+				// Nothing in flight and no reason to keep racing: hand the
+				// fallback (or the error) to the retry loop.
+				if fallback != nil {
+					return finish(*fallback)
+				}
+				return nil, lastErr
+			}
+		case <-timer.C:
+			if launched < total {
+				launch()
+				launched++
+				if launched < total {
+					timer.Reset(cfg.Delay)
+				}
+			}
+		case <-ctx.Done():
+			if fallback != nil {
+				fallback.resp.Body.Close()
+			}
+			reap(-1)
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package common
+
+import "net/http"
+
+// RequestHook runs before each attempt is sent and may mutate the
+// request — auth header injection and correlation ID forwarding live
+// here rather than at every call site. Returning an error aborts the
+// call.
+type RequestHook func(req *http.Request) error
+
+// ResponseHook runs after each attempt completes, for logging with
+// redaction, audit trails, and the like. resp is nil when the attempt
+// failed at the transport level; hooks must not consume the body.
+type ResponseHook func(req *http.Request, resp *http.Response, err error)
+
+// WithRequestHook appends a hook run before every attempt, in the order
+// the hooks were added. Like WithMetrics, hooks fire per attempt, so
+// retries are visible to them.
+func WithRequestHook(hook RequestHook) HTTPClientOption {
+	return func(c *HTTPClient) { c.requestHooks = append(c.requestHooks, hook) }
+}
+
+// WithResponseHook appends a hook run after every attempt.
+func WithResponseHook(hook ResponseHook) HTTPClientOption {
+	return func(c *HTTPClient) { c.responseHooks = append(c.responseHooks, hook) }
+}
@@ -0,0 +1,249 @@
+// Package common holds small shared utilities used across services.
+package common
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPClient is the shared outbound HTTP client used by external
+// integrations (Kavenegar, Shahkar, ...). It wraps net/http with the
+// cross-cutting behavior every integration needs, configured through
+// options.
+type HTTPClient struct {
+	client  *http.Client
+	baseURL string
+	headers http.Header
+	// retry, breakers, and limiters are nil unless the matching option
+	// was given.
+	retry    *RetryPolicy
+	breakers *breakerSet
+	limiters *limiterSet
+	hedge    *HedgeConfig
+	// maxBody caps response bodies when positive; see WithMaxResponseBytes.
+	maxBody int64
+	// Hooks run around every attempt; see WithRequestHook/WithResponseHook.
+	requestHooks  []RequestHook
+	responseHooks []ResponseHook
+}
+
+// HTTPClientOption configures an HTTPClient at construction time.
+type HTTPClientOption func(*HTTPClient)
+
+// WithBaseURL prefixes relative request URLs with base.
+func WithBaseURL(base string) HTTPClientOption {
+	return func(c *HTTPClient) { c.baseURL = base }
+}
+
+// WithClientTimeout sets the overall per-request timeout.
+func WithClientTimeout(d time.Duration) HTTPClientOption {
+	return func(c *HTTPClient) { c.client.Timeout = d }
+}
+
+// WithDefaultHeader adds a header sent on every request.
+func WithDefaultHeader(key, value string) HTTPClientOption {
+	return func(c *HTTPClient) { c.headers.Add(key, value) }
+}
+
+// NewHTTPClient builds a client with the given options.
+func NewHTTPClient(opts ...HTTPClientOption) *HTTPClient {
+	c := &HTTPClient{
+		client:  &http.Client{Timeout: 30 * time.Second},
+		headers: make(http.Header),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// RequestOption mutates a single outgoing request.
+type RequestOption func(*http.Request) error
+
+// WithHeader sets a header on one request.
+func WithHeader(key, value string) RequestOption {
+	return func(req *http.Request) error {
+		req.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQuery adds a query parameter to one request.
+func WithQuery(key, value string) RequestOption {
+	return func(req *http.Request) error {
+		q := req.URL.Query()
+		q.Set(key, value)
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// WithTimeout bounds one request to d, for providers that need a
+// tighter deadline than the client default. The effective deadline is
+// the sooner of this and the client timeout, so it can only shorten;
+// integrations that need a longer window overall should raise
+// WithClientTimeout instead. With retries, it applies per attempt, like
+// the client timeout.
+func WithTimeout(d time.Duration) RequestOption {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), d)
+		context.AfterFunc(ctx, cancel)
+		*req = *req.WithContext(ctx)
+		return nil
+	}
+}
+
+// Get performs a GET request.
+func (c *HTTPClient) Get(ctx context.Context, url string, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, http.MethodGet, url, "", nil, opts...)
+}
+
+// Post performs a POST request with the given body.
+func (c *HTTPClient) Post(ctx context.Context, url, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, http.MethodPost, url, contentType, body, opts...)
+}
+
+// Put performs a PUT request with the given body.
+func (c *HTTPClient) Put(ctx context.Context, url, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, http.MethodPut, url, contentType, body, opts...)
+}
+
+// Patch performs a PATCH request with the given body.
+func (c *HTTPClient) Patch(ctx context.Context, url, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, http.MethodPatch, url, contentType, body, opts...)
+}
+
+// Delete performs a DELETE request.
+func (c *HTTPClient) Delete(ctx context.Context, url string, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, http.MethodDelete, url, "", nil, opts...)
+}
+
+// Head performs a HEAD request.
+func (c *HTTPClient) Head(ctx context.Context, url string, opts ...RequestOption) (*http.Response, error) {
+	return c.do(ctx, http.MethodHead, url, "", nil, opts...)
+}
+
+func (c *HTTPClient) do(ctx context.Context, method, url, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	attempts := 1
+	var payload []byte
+	if c.retry != nil && retryableMethod(method, c.retry) {
+		attempts = c.retry.MaxAttempts
+		// Retrying means re-sending the body, so it has to be buffered
+		// up front; a plain reader is consumed by the first attempt.
+		if body != nil {
+			var err error
+			if payload, err = io.ReadAll(body); err != nil {
+				return nil, fmt.Errorf("read %s %s body: %w", method, url, err)
+			}
+		}
+	}
+
+	var breaker *hostBreaker
+	if c.breakers != nil {
+		breaker = c.breakers.forURL(c.baseURL + url)
+	}
+	var limiter *hostLimiter
+	if c.limiters != nil {
+		limiter = c.limiters.forURL(c.baseURL + url)
+	}
+
+	for attempt := 0; ; attempt++ {
+		if limiter != nil {
+			// Throttle before the breaker so requests we reject ourselves
+			// never count against the provider's health.
+			if err := limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if breaker != nil {
+			if err := breaker.allow(); err != nil {
+				return nil, err
+			}
+		}
+		attemptBody := body
+		if payload != nil {
+			attemptBody = bytes.NewReader(payload)
+		}
+		var resp *http.Response
+		var err error
+		if c.hedge != nil && attemptBody == nil && hedgeableMethod(method) {
+			resp, err = c.doHedged(ctx, method, url, contentType, opts...)
+		} else {
+			resp, err = c.doOnce(ctx, method, url, contentType, attemptBody, opts...)
+		}
+		if breaker != nil {
+			breaker.record(err != nil || retryableStatus(resp.StatusCode))
+		}
+		if err == nil && !retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+		if attempt >= attempts-1 || ctx.Err() != nil {
+			// Out of attempts: hand back whatever the last try produced,
+			// so callers still see the final status or error unchanged.
+			return resp, err
+		}
+		if err == nil {
+			// Drain so the connection can be reused for the retry.
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		select {
+		case <-time.After(c.retry.delay(attempt)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (c *HTTPClient) doOnce(ctx context.Context, method, url, contentType string, body io.Reader, opts ...RequestOption) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+url, body)
+	if err != nil {
+		return nil, fmt.Errorf("build %s %s: %w", method, url, err)
+	}
+	for key, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for _, opt := range opts {
+		if err := opt(req); err != nil {
+			return nil, err
+		}
+	}
+	for _, hook := range c.requestHooks {
+		if err := hook(req); err != nil {
+			return nil, fmt.Errorf("%s %s: request hook: %w", method, url, err)
+		}
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		err = fmt.Errorf("%s %s: %w", method, url, err)
+	} else if c.maxBody > 0 {
+		resp.Body = &limitedBody{rc: resp.Body, remaining: c.maxBody}
+	}
+	for _, hook := range c.responseHooks {
+		hook(req, resp, err)
+	}
+	return resp, err
+}
+
+// ReadResponseBody drains and closes the response body, returning it as a
+// byte slice. Callers get a non-nil error for non-2xx statuses.
+func ReadResponseBody(resp *http.Response) ([]byte, error) {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return body, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return body, nil
+}
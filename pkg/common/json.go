@@ -0,0 +1,60 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// contentTypeJSON is sent on JSON requests and required on their
+// responses.
+const contentTypeJSON = "application/json"
+
+// GetJSON performs a GET and decodes the JSON response into T. The
+// response is returned alongside for status and header access; its body
+// is always drained and closed here.
+func GetJSON[T any](ctx context.Context, c *HTTPClient, url string, opts ...RequestOption) (T, *http.Response, error) {
+	var zero T
+	resp, err := c.Get(ctx, url, opts...)
+	if err != nil {
+		return zero, nil, err
+	}
+	out, err := decodeJSON[T](resp)
+	return out, resp, err
+}
+
+// PostJSON marshals payload, performs a POST, and decodes the JSON
+// response into T.
+func PostJSON[T any](ctx context.Context, c *HTTPClient, url string, payload any, opts ...RequestOption) (T, *http.Response, error) {
+	var zero T
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return zero, nil, fmt.Errorf("encode request body: %w", err)
+	}
+	resp, err := c.Post(ctx, url, contentTypeJSON, bytes.NewReader(body), opts...)
+	if err != nil {
+		return zero, nil, err
+	}
+	out, err := decodeJSON[T](resp)
+	return out, resp, err
+}
+
+// decodeJSON enforces status and content type, then unmarshals the
+// drained body.
+func decodeJSON[T any](resp *http.Response) (T, error) {
+	var out T
+	body, err := ReadResponseBody(resp)
+	if err != nil {
+		return out, err
+	}
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, contentTypeJSON) {
+		return out, fmt.Errorf("expected %s response, got %q", contentTypeJSON, ct)
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return out, fmt.Errorf("decode response body: %w", err)
+	}
+	return out, nil
+}
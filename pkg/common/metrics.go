@@ -0,0 +1,64 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Outbound HTTP metrics, labeled by host so provider degradation
+// (Shahkar slow, Kavenegar erroring) alerts separately from our own
+// latency. Paths are deliberately not a label: they embed identifiers
+// and would blow up cardinality.
+var (
+	outboundRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "infera",
+		Subsystem: "outbound_http",
+		Name:      "requests_total",
+		Help:      "Outbound HTTP requests by host, method, and status class.",
+	}, []string{"host", "method", "status_class"})
+
+	outboundDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "infera",
+		Subsystem: "outbound_http",
+		Name:      "request_duration_seconds",
+		Help:      "Outbound HTTP request duration by host and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"host", "method"})
+)
+
+// WithMetrics instruments the client's transport with Prometheus
+// request counts and duration histograms. Stack it under WithTracing so
+// spans include the instrumented time.
+func WithMetrics() HTTPClientOption {
+	return func(c *HTTPClient) {
+		base := c.client.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		c.client.Transport = &metricsTransport{base: base}
+	}
+}
+
+// metricsTransport records one observation per attempt, so retries and
+// breaker probes are visible rather than folded into one sample.
+type metricsTransport struct {
+	base http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	host := req.URL.Host
+
+	class := "error"
+	if err == nil {
+		class = fmt.Sprintf("%dxx", resp.StatusCode/100)
+	}
+	outboundRequests.WithLabelValues(host, req.Method, class).Inc()
+	outboundDuration.WithLabelValues(host, req.Method).Observe(time.Since(start).Seconds())
+	return resp, err
+}
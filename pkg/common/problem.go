@@ -0,0 +1,46 @@
+package common
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Problem is an RFC 7807 error response. All HTTP surfaces return this
+// shape instead of ad-hoc http.Error strings, so clients parse one
+// format everywhere.
+type Problem struct {
+	// Type is a URI identifying the error class; empty renders the RFC
+	// default "about:blank".
+	Type string `json:"type,omitempty"`
+	// Title is the short human-readable summary; defaults to the status
+	// text.
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	// Detail explains this occurrence, when saying more than Title is
+	// safe.
+	Detail string `json:"detail,omitempty"`
+	// Instance is the request path the problem occurred on.
+	Instance string `json:"instance,omitempty"`
+	// Code is our machine-readable extension, e.g. "auth.otp_invalid",
+	// stable across wording changes.
+	Code string `json:"code,omitempty"`
+}
+
+// contentTypeProblem is the RFC 7807 media type.
+const contentTypeProblem = "application/problem+json"
+
+// WriteProblem renders p onto w, filling Title and Instance defaults.
+func WriteProblem(w http.ResponseWriter, r *http.Request, p Problem) {
+	if p.Status == 0 {
+		p.Status = http.StatusInternalServerError
+	}
+	if p.Title == "" {
+		p.Title = http.StatusText(p.Status)
+	}
+	if p.Instance == "" && r != nil {
+		p.Instance = r.URL.Path
+	}
+	w.Header().Set("Content-Type", contentTypeProblem)
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
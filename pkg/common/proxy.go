@@ -0,0 +1,48 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// WithProxy routes all requests through the given proxy. HTTP, HTTPS,
+// and SOCKS5 proxies are supported via the URL scheme (for example
+// "http://egress.internal:3128" or "socks5://egress.internal:1080");
+// some external providers are only reachable through such an egress
+// proxy. Returns an error for an unparsable URL so misconfiguration
+// fails at startup.
+func WithProxy(rawURL string) (HTTPClientOption, error) {
+	proxyURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("common: parse proxy URL: %w", err)
+	}
+	return func(c *HTTPClient) {
+		transport := baseTransport(c)
+		transport.Proxy = http.ProxyURL(proxyURL)
+		c.client.Transport = transport
+	}, nil
+}
+
+// WithProxyFromEnvironment honors the standard HTTP_PROXY, HTTPS_PROXY,
+// and NO_PROXY variables, for deployments where the egress proxy is set
+// cluster-wide rather than per integration.
+func WithProxyFromEnvironment() HTTPClientOption {
+	return func(c *HTTPClient) {
+		transport := baseTransport(c)
+		transport.Proxy = http.ProxyFromEnvironment
+		c.client.Transport = transport
+	}
+}
+
+// baseTransport returns the client's *http.Transport for options that
+// mutate transport fields, cloning the default when none is set yet.
+// Such options must be applied before wrapping ones like WithTracing
+// and WithMetrics.
+func baseTransport(c *HTTPClient) *http.Transport {
+	if transport, ok := c.client.Transport.(*http.Transport); ok {
+		return transport
+	}
+	base, _ := http.DefaultTransport.(*http.Transport)
+	return base.Clone()
+}
@@ -0,0 +1,124 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned in fail-fast mode when a host's token
+// bucket is empty.
+var ErrRateLimited = errors.New("common: outbound rate limit exceeded")
+
+// RateLimitConfig tunes the per-host token bucket.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained refill rate.
+	RequestsPerSecond float64
+	// Burst is the bucket capacity: how many requests may go out
+	// back-to-back before the sustained rate applies.
+	Burst int
+	// Wait makes an empty bucket queue the attempt until a token frees
+	// up (honoring the context) instead of failing fast with
+	// ErrRateLimited.
+	Wait bool
+}
+
+// DefaultRateLimitConfig stays well inside typical provider quotas.
+var DefaultRateLimitConfig = RateLimitConfig{
+	RequestsPerSecond: 10,
+	Burst:             20,
+}
+
+// WithRateLimit throttles requests per destination host so provider
+// quotas (Kavenegar, Shahkar) hold even through internal retries and
+// bursts. Zero-valued fields fall back to DefaultRateLimitConfig.
+func WithRateLimit(cfg RateLimitConfig) HTTPClientOption {
+	if cfg.RequestsPerSecond <= 0 {
+		cfg.RequestsPerSecond = DefaultRateLimitConfig.RequestsPerSecond
+	}
+	if cfg.Burst <= 0 {
+		cfg.Burst = DefaultRateLimitConfig.Burst
+	}
+	return func(c *HTTPClient) {
+		c.limiters = &limiterSet{cfg: cfg, hosts: make(map[string]*hostLimiter)}
+	}
+}
+
+// limiterSet keys token buckets by destination host, like breakerSet.
+type limiterSet struct {
+	cfg RateLimitConfig
+
+	mu    sync.Mutex
+	hosts map[string]*hostLimiter
+}
+
+func (s *limiterSet) forURL(rawURL string) *hostLimiter {
+	host := ""
+	if u, err := url.Parse(rawURL); err == nil {
+		host = u.Host
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.hosts[host]
+	if !ok {
+		l = &hostLimiter{cfg: s.cfg, tokens: float64(s.cfg.Burst), last: time.Now()}
+		s.hosts[host] = l
+	}
+	return l
+}
+
+// hostLimiter is a token bucket refilled continuously at the sustained
+// rate.
+type hostLimiter struct {
+	cfg RateLimitConfig
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// wait takes one token, sleeping for it in queue mode. Every attempt
+// pays, so retries count against the quota too.
+func (l *hostLimiter) wait(ctx context.Context) error {
+	delay, err := l.take()
+	if err != nil {
+		return err
+	}
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// take consumes a token, returning how long the caller must wait for
+// it. In fail-fast mode an empty bucket returns ErrRateLimited instead.
+func (l *hostLimiter) take() (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.cfg.RequestsPerSecond
+	if max := float64(l.cfg.Burst); l.tokens > max {
+		l.tokens = max
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0, nil
+	}
+	if !l.cfg.Wait {
+		return 0, ErrRateLimited
+	}
+	// Charge the token now so queued waiters line up instead of all
+	// releasing at once.
+	l.tokens--
+	return time.Duration((-l.tokens / l.cfg.RequestsPerSecond) * float64(time.Second)), nil
+}
@@ -0,0 +1,71 @@
+package common
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// sensitiveKeys are attribute names whose values are always masked,
+// wherever they appear in a log record or span.
+var sensitiveKeys = map[string]bool{
+	"mobile":        true,
+	"national_id":   true,
+	"iban":          true,
+	"token":         true,
+	"otp":           true,
+	"password":      true,
+	"authorization": true,
+}
+
+// Value patterns masked regardless of key name, for PII that sneaks in
+// under ad-hoc keys: Iranian mobile numbers and JWT-shaped tokens.
+var (
+	mobilePattern = regexp.MustCompile(`^(\+98|0)?9\d{9}$`)
+	jwtPattern    = regexp.MustCompile(`^eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+$`)
+)
+
+// Mask redacts a value, keeping just enough shape to correlate log
+// lines: the first and last two characters of anything long enough,
+// stars for the rest.
+func Mask(value string) string {
+	if len(value) <= 4 {
+		return "****"
+	}
+	return value[:2] + "****" + value[len(value)-2:]
+}
+
+// shouldMask reports whether a key/value pair is sensitive.
+func shouldMask(key, value string) bool {
+	if sensitiveKeys[strings.ToLower(key)] {
+		return true
+	}
+	return mobilePattern.MatchString(value) || jwtPattern.MatchString(value)
+}
+
+// RedactAttr is a slog ReplaceAttr function; wire it once per service:
+//
+//	slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{ReplaceAttr: common.RedactAttr}))
+//
+// and every log site masks sensitive values without changing.
+func RedactAttr(_ []string, a slog.Attr) slog.Attr {
+	if a.Value.Kind() != slog.KindString {
+		return a
+	}
+	if value := a.Value.String(); shouldMask(a.Key, value) {
+		a.Value = slog.StringValue(Mask(value))
+	}
+	return a
+}
+
+// RedactedAttribute builds a span attribute, masking sensitive keys
+// and values; use it instead of attribute.String for anything
+// user-supplied.
+func RedactedAttribute(key, value string) attribute.KeyValue {
+	if shouldMask(key, value) {
+		value = Mask(value)
+	}
+	return attribute.String(key, value)
+}
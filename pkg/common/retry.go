@@ -0,0 +1,78 @@
+package common
+
+import (
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// RetryPolicy configures HTTPClient retries. The zero value disables
+// them; DefaultRetryPolicy is a sane starting point for the external
+// integrations (Shahkar, Kavenegar, ...) that fail on transient blips.
+type RetryPolicy struct {
+	// MaxAttempts bounds tries in total, first attempt included.
+	MaxAttempts int
+	// BaseDelay doubles per attempt, with full jitter.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff growth.
+	MaxDelay time.Duration
+	// RetryPost opts POST requests into retries. POSTs are not
+	// idempotent in general, so callers must know their provider
+	// deduplicates (or tolerates duplicates) before setting this.
+	RetryPost bool
+}
+
+// DefaultRetryPolicy retries twice with jittered backoff.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   200 * time.Millisecond,
+		MaxDelay:    5 * time.Second,
+	}
+}
+
+// WithRetry enables retries on connection errors and 5xx responses for
+// idempotent requests (and POSTs when the policy opts them in).
+func WithRetry(policy RetryPolicy) HTTPClientOption {
+	defaults := DefaultRetryPolicy()
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = defaults.BaseDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = defaults.MaxDelay
+	}
+	return func(c *HTTPClient) { c.retry = &policy }
+}
+
+// delay is the jittered backoff before the given retry (0-based).
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	max := p.BaseDelay << attempt
+	if max > p.MaxDelay || max <= 0 {
+		max = p.MaxDelay
+	}
+	// Full jitter spreads concurrent retries instead of synchronizing
+	// them into waves.
+	return time.Duration(rand.Int63n(int64(max)) + 1)
+}
+
+// retryableMethod reports whether a request may be re-sent. Idempotent
+// methods always may; POST only when the policy opts it in.
+func retryableMethod(method string, policy *RetryPolicy) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return policy.RetryPost
+	default:
+		return false
+	}
+}
+
+// retryableStatus reports whether a response is worth retrying: the
+// server failed, not the request.
+func retryableStatus(code int) bool {
+	return code >= 500
+}
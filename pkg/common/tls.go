@@ -0,0 +1,89 @@
+package common
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// TLSSettings configures transport security for HTTPClient. Some
+// government and banking integrations mandate mTLS and private CAs;
+// this keeps their setup declarative.
+type TLSSettings struct {
+	// CABundle is a PEM bundle appended to the system roots, for
+	// providers signed by a private CA.
+	CABundle []byte
+	// ClientCert and ClientKey are a PEM pair presented to the server
+	// (mTLS). Both or neither must be set.
+	ClientCert []byte
+	ClientKey  []byte
+	// MinVersion defaults to TLS 1.2.
+	MinVersion uint16
+	// PinnedCertSHA256 lists hex SHA-256 fingerprints of acceptable leaf
+	// certificates; empty disables pinning. Pinning is on top of normal
+	// chain verification, not instead of it.
+	PinnedCertSHA256 []string
+}
+
+// NewTLSConfig builds a tls.Config from the settings.
+func NewTLSConfig(s TLSSettings) (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: s.MinVersion}
+	if cfg.MinVersion == 0 {
+		cfg.MinVersion = tls.VersionTLS12
+	}
+
+	if len(s.CABundle) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(s.CABundle) {
+			return nil, fmt.Errorf("common: no certificates in CA bundle")
+		}
+		cfg.RootCAs = pool
+	}
+
+	if len(s.ClientCert) > 0 || len(s.ClientKey) > 0 {
+		cert, err := tls.X509KeyPair(s.ClientCert, s.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("common: load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if len(s.PinnedCertSHA256) > 0 {
+		pins := make(map[string]bool, len(s.PinnedCertSHA256))
+		for _, pin := range s.PinnedCertSHA256 {
+			pins[strings.ToLower(pin)] = true
+		}
+		cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			for _, raw := range rawCerts {
+				sum := sha256.Sum256(raw)
+				if pins[hex.EncodeToString(sum[:])] {
+					return nil
+				}
+			}
+			return fmt.Errorf("common: peer certificate does not match any pin")
+		}
+	}
+	return cfg, nil
+}
+
+// WithTLSSettings applies the settings to the client's transport.
+// Returns the option and any settings error, so misconfigured pins or
+// key pairs fail at startup instead of on the first call. Apply before
+// WithTracing/WithMetrics so those wrap the configured transport.
+func WithTLSSettings(s TLSSettings) (HTTPClientOption, error) {
+	tlsCfg, err := NewTLSConfig(s)
+	if err != nil {
+		return nil, err
+	}
+	return func(c *HTTPClient) {
+		transport := baseTransport(c)
+		transport.TLSClientConfig = tlsCfg
+		c.client.Transport = transport
+	}, nil
+}
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// WithTracing wraps the client's transport with otelhttp: every
+// outbound call becomes a child span of the caller's span, carrying
+// URL, status, and duration, and W3C trace headers are injected so a
+// provider that forwards them stays in the same trace. External
+// integrations (Shahkar, Kavenegar, Enamad) should all enable this.
+func WithTracing() HTTPClientOption {
+	return func(c *HTTPClient) {
+		base := c.client.Transport
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		c.client.Transport = otelhttp.NewTransport(base,
+			otelhttp.WithSpanNameFormatter(spanName))
+	}
+}
+
+// spanName keeps cardinality low: method and host, never the full path
+// (which can embed identifiers).
+func spanName(_ string, req *http.Request) string {
+	return fmt.Sprintf("HTTP %s %s", req.Method, req.URL.Host)
+}
@@ -0,0 +1,58 @@
+package common
+
+import (
+	"net"
+	"time"
+)
+
+// TransportConfig tunes the connection pool behind the client. The
+// net/http zero values keep too few idle connections for
+// high-throughput gateways, which shows up as connection churn and TLS
+// handshakes on the hot path.
+type TransportConfig struct {
+	// MaxIdleConnsPerHost is the connection pool size per destination.
+	MaxIdleConnsPerHost int
+	// IdleConnTimeout closes pooled connections idle this long.
+	IdleConnTimeout time.Duration
+	// DialTimeout bounds TCP connection establishment, separate from the
+	// per-request timeout.
+	DialTimeout time.Duration
+	// DisableKeepAlives forces a fresh connection per request; only for
+	// providers whose middleboxes mishandle reuse.
+	DisableKeepAlives bool
+}
+
+// DefaultTransportConfig suits a service calling one or two external
+// providers.
+var DefaultTransportConfig = TransportConfig{
+	MaxIdleConnsPerHost: 16,
+	IdleConnTimeout:     90 * time.Second,
+	DialTimeout:         5 * time.Second,
+}
+
+// WithTransport applies the tuning to the client's transport.
+// Zero-valued fields fall back to DefaultTransportConfig. Like the
+// other transport-mutating options, apply it before WithTracing and
+// WithMetrics.
+func WithTransport(cfg TransportConfig) HTTPClientOption {
+	if cfg.MaxIdleConnsPerHost <= 0 {
+		cfg.MaxIdleConnsPerHost = DefaultTransportConfig.MaxIdleConnsPerHost
+	}
+	if cfg.IdleConnTimeout <= 0 {
+		cfg.IdleConnTimeout = DefaultTransportConfig.IdleConnTimeout
+	}
+	if cfg.DialTimeout <= 0 {
+		cfg.DialTimeout = DefaultTransportConfig.DialTimeout
+	}
+	return func(c *HTTPClient) {
+		transport := baseTransport(c)
+		transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		transport.IdleConnTimeout = cfg.IdleConnTimeout
+		transport.DisableKeepAlives = cfg.DisableKeepAlives
+		transport.DialContext = (&net.Dialer{
+			Timeout:   cfg.DialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
+		c.client.Transport = transport
+	}
+}
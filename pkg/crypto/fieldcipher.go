@@ -0,0 +1,134 @@
+// Package crypto holds application-level encryption helpers, so a
+// database dump alone does not expose PII.
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Ciphertext format: "enc:v1:<key id>:<base64(nonce || ciphertext)>".
+// The key ID makes rotation possible — add the new key, switch the
+// active ID, and old rows keep decrypting until they are rewritten.
+const cipherPrefix = "enc:v1:"
+
+// ErrUnknownKey means a ciphertext references a key the cipher was not
+// configured with — usually a key retired too early.
+var ErrUnknownKey = errors.New("crypto: ciphertext references unknown key")
+
+// FieldCipher encrypts individual column values (national IDs, IBANs,
+// mobiles) with AES-256-GCM.
+type FieldCipher struct {
+	active string
+	keys   map[string]fieldKey
+}
+
+type fieldKey struct {
+	aead cipher.AEAD
+	// mac derives deterministic nonces; see EncryptDeterministic.
+	mac []byte
+}
+
+// NewFieldCipher builds a cipher from 32-byte keys indexed by ID.
+// activeKeyID selects the key used for new writes; the rest are
+// decrypt-only.
+func NewFieldCipher(activeKeyID string, keys map[string][]byte) (*FieldCipher, error) {
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("crypto: active key %q not in key set", activeKeyID)
+	}
+	c := &FieldCipher{active: activeKeyID, keys: make(map[string]fieldKey, len(keys))}
+	for id, key := range keys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("crypto: key %q must be 32 bytes, got %d", id, len(key))
+		}
+		if strings.Contains(id, ":") {
+			return nil, fmt.Errorf("crypto: key ID %q must not contain ':'", id)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("crypto: key %q: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("crypto: key %q: %w", id, err)
+		}
+		nonceMAC := sha256.Sum256(append([]byte("nonce:"), key...))
+		c.keys[id] = fieldKey{aead: aead, mac: nonceMAC[:]}
+	}
+	return c, nil
+}
+
+// Encrypt encrypts with a random nonce. Use it for values that are
+// only ever read back, never looked up by equality.
+func (c *FieldCipher) Encrypt(plaintext string) (string, error) {
+	key := c.keys[c.active]
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("crypto: nonce: %w", err)
+	}
+	return c.seal(key, nonce, plaintext), nil
+}
+
+// EncryptDeterministic derives the nonce from the plaintext (SIV
+// style), so equal values produce equal ciphertexts. That keeps unique
+// indexes and WHERE-equality working on encrypted columns, at the
+// known cost of revealing which rows share a value — acceptable for
+// identifiers that are unique per row anyway.
+func (c *FieldCipher) EncryptDeterministic(plaintext string) string {
+	key := c.keys[c.active]
+	mac := hmac.New(sha256.New, key.mac)
+	mac.Write([]byte(plaintext))
+	nonce := mac.Sum(nil)[:key.aead.NonceSize()]
+	return c.seal(key, nonce, plaintext)
+}
+
+func (c *FieldCipher) seal(key fieldKey, nonce []byte, plaintext string) string {
+	sealed := key.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return cipherPrefix + c.active + ":" + base64.RawStdEncoding.EncodeToString(sealed)
+}
+
+// Decrypt reverses either encryption mode. Values without the
+// ciphertext prefix pass through unchanged, so rows written before
+// encryption was enabled keep working and can be migrated lazily.
+func (c *FieldCipher) Decrypt(value string) (string, error) {
+	rest, ok := strings.CutPrefix(value, cipherPrefix)
+	if !ok {
+		return value, nil
+	}
+	keyID, encoded, ok := strings.Cut(rest, ":")
+	if !ok {
+		return "", fmt.Errorf("crypto: malformed ciphertext")
+	}
+	key, known := c.keys[keyID]
+	if !known {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKey, keyID)
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < key.aead.NonceSize() {
+		return "", fmt.Errorf("crypto: malformed ciphertext")
+	}
+	nonce, ciphertext := sealed[:key.aead.NonceSize()], sealed[key.aead.NonceSize():]
+	plaintext, err := key.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("crypto: decrypt: %w", err)
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRewrite reports whether value predates the active key (or
+// encryption entirely), for lazy re-encryption sweeps.
+func (c *FieldCipher) NeedsRewrite(value string) bool {
+	rest, ok := strings.CutPrefix(value, cipherPrefix)
+	if !ok {
+		return value != ""
+	}
+	keyID, _, _ := strings.Cut(rest, ":")
+	return keyID != c.active
+}
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type readOnlyKey struct{}
+
+// ReadOnly marks the context so cluster-aware queries may run on a read
+// replica. Services set it on heavy list/search paths; anything that
+// writes, or must read its own writes, stays on the primary by simply
+// not setting it.
+func ReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func isReadOnly(ctx context.Context) bool {
+	marked, _ := ctx.Value(readOnlyKey{}).(bool)
+	return marked
+}
+
+// ClusterConfig tunes replica routing.
+type ClusterConfig struct {
+	// MaxLag is the replication lag beyond which a replica stops
+	// receiving queries.
+	MaxLag time.Duration
+	// ProbeInterval is how often Run measures each replica's lag.
+	ProbeInterval time.Duration
+}
+
+// DefaultClusterConfig tolerates a few seconds of lag, fine for lists
+// and search but not for read-your-writes flows.
+var DefaultClusterConfig = ClusterConfig{
+	MaxLag:        5 * time.Second,
+	ProbeInterval: 15 * time.Second,
+}
+
+// Cluster routes queries across a primary pool and read replicas.
+// Read-marked contexts round-robin over replicas whose measured lag is
+// acceptable; everything else — and everything when no replica is
+// healthy — goes to the primary.
+type Cluster struct {
+	primary  *pgxpool.Pool
+	replicas []*clusterReplica
+	next     atomic.Uint64
+	cfg      ClusterConfig
+	log      *slog.Logger
+}
+
+type clusterReplica struct {
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
+}
+
+// NewCluster builds a cluster. Zero-valued config fields fall back to
+// DefaultClusterConfig; replicas start healthy until the first probe
+// says otherwise.
+func NewCluster(primary *pgxpool.Pool, replicas []*pgxpool.Pool, cfg ClusterConfig, log *slog.Logger) *Cluster {
+	if cfg.MaxLag <= 0 {
+		cfg.MaxLag = DefaultClusterConfig.MaxLag
+	}
+	if cfg.ProbeInterval <= 0 {
+		cfg.ProbeInterval = DefaultClusterConfig.ProbeInterval
+	}
+	c := &Cluster{primary: primary, cfg: cfg, log: log}
+	for _, pool := range replicas {
+		r := &clusterReplica{pool: pool}
+		r.healthy.Store(true)
+		c.replicas = append(c.replicas, r)
+	}
+	return c
+}
+
+// Pool returns the pool to use for this context: a healthy replica for
+// read-only contexts, the primary otherwise.
+func (c *Cluster) Pool(ctx context.Context) *pgxpool.Pool {
+	if !isReadOnly(ctx) || len(c.replicas) == 0 {
+		return c.primary
+	}
+	start := c.next.Add(1)
+	for i := 0; i < len(c.replicas); i++ {
+		r := c.replicas[(int(start)+i)%len(c.replicas)]
+		if r.healthy.Load() {
+			return r.pool
+		}
+	}
+	return c.primary
+}
+
+// Querier resolves the querier for this context: a transaction bound by
+// TxManager wins, then replica routing via Pool.
+func (c *Cluster) Querier(ctx context.Context) Querier {
+	return QuerierFrom(ctx, c.Pool(ctx))
+}
+
+// Run probes replica lag until ctx is canceled; start it alongside the
+// service's other background workers. Without it, replicas stay in
+// their initial healthy state.
+func (c *Cluster) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.ProbeInterval)
+	defer ticker.Stop()
+	for {
+		c.probe(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *Cluster) probe(ctx context.Context) {
+	for i, r := range c.replicas {
+		var lagSeconds float64
+		err := r.pool.QueryRow(ctx, `
+			SELECT COALESCE(EXTRACT(EPOCH FROM (now() - pg_last_xact_replay_timestamp())), 0)`,
+		).Scan(&lagSeconds)
+		healthy := err == nil && time.Duration(lagSeconds*float64(time.Second)) <= c.cfg.MaxLag
+		if healthy != r.healthy.Swap(healthy) {
+			if healthy {
+				c.log.Info("db: replica back in rotation", "replica", i)
+			} else {
+				c.log.Warn("db: replica out of rotation", "replica", i, "lag_seconds", lagSeconds, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CredentialProvider supplies database credentials on demand. It is
+// consulted before every new connection, so rotating credentials
+// (vault leases, IAM-style auth tokens) are picked up without a
+// restart.
+type CredentialProvider interface {
+	Credentials(ctx context.Context) (user, password string, err error)
+}
+
+// Config describes how a service connects to Postgres. Exactly one
+// password source should be set; production deployments use
+// PasswordFile or Credentials so no plaintext password lives in YAML.
+type Config struct {
+	// DSN is a full connection string or URL, e.g.
+	// "postgres://infera@db:5432/customer?sslmode=verify-full&sslrootcert=/etc/ssl/db-ca.pem".
+	// When set, the discrete fields below are ignored.
+	DSN string
+
+	Host     string
+	Port     int
+	Database string
+	User     string
+	// SSLMode is passed through ("require", "verify-full", ...); empty
+	// leaves the driver default.
+	SSLMode string
+	// SSLRootCert is the CA bundle path for certificate verification.
+	SSLRootCert string
+
+	// Password is a plaintext password, for local development.
+	Password string
+	// PasswordFile reads the password from a mounted secret file.
+	PasswordFile string
+	// Credentials overrides both when set.
+	Credentials CredentialProvider
+
+	// MaxConns caps the pool; zero keeps the pgx default.
+	MaxConns int32
+}
+
+// dsn renders the discrete fields as a key=value connection string.
+func (c Config) dsn() string {
+	parts := []string{
+		fmt.Sprintf("host=%s", c.Host),
+		fmt.Sprintf("dbname=%s", c.Database),
+		fmt.Sprintf("user=%s", c.User),
+	}
+	if c.Port > 0 {
+		parts = append(parts, fmt.Sprintf("port=%d", c.Port))
+	}
+	if c.Password != "" {
+		parts = append(parts, fmt.Sprintf("password=%s", c.Password))
+	}
+	if c.SSLMode != "" {
+		parts = append(parts, fmt.Sprintf("sslmode=%s", c.SSLMode))
+	}
+	if c.SSLRootCert != "" {
+		parts = append(parts, fmt.Sprintf("sslrootcert=%s", c.SSLRootCert))
+	}
+	return strings.Join(parts, " ")
+}
+
+// connectTimeout bounds the startup ping, so a bad address or rejected
+// certificate fails the boot instead of the first query.
+const connectTimeout = 5 * time.Second
+
+// Connect builds and verifies a pool from the config.
+func Connect(ctx context.Context, cfg Config) (*pgxpool.Pool, error) {
+	dsn := cfg.DSN
+	if dsn == "" {
+		dsn = cfg.dsn()
+	}
+	poolCfg, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("db: parse config: %w", err)
+	}
+	if cfg.MaxConns > 0 {
+		poolCfg.MaxConns = cfg.MaxConns
+	}
+
+	if cfg.PasswordFile != "" {
+		secret, err := os.ReadFile(cfg.PasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("db: read password file: %w", err)
+		}
+		poolCfg.ConnConfig.Password = strings.TrimSpace(string(secret))
+	}
+	if cfg.Credentials != nil {
+		poolCfg.BeforeConnect = func(ctx context.Context, connCfg *pgx.ConnConfig) error {
+			user, password, err := cfg.Credentials.Credentials(ctx)
+			if err != nil {
+				return fmt.Errorf("db: fetch credentials: %w", err)
+			}
+			if user != "" {
+				connCfg.User = user
+			}
+			connCfg.Password = password
+			return nil
+		}
+	}
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, fmt.Errorf("db: build pool: %w", err)
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, connectTimeout)
+	defer cancel()
+	if err := pool.Ping(pingCtx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("db: connect: %w", err)
+	}
+	return pool, nil
+}
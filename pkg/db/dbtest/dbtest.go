@@ -0,0 +1,100 @@
+// Package dbtest spins up throwaway Postgres and Redis containers for
+// repository tests, so they run anywhere Docker does instead of against
+// a shared database. Typical use:
+//
+//	pool := dbtest.StartPostgres(t, migrations.FS)
+//	ctx := dbtest.Isolate(t, pool)
+//	repo := repository.NewCustomerRepository(pool)
+//	// repo calls under ctx share one transaction, rolled back at
+//	// test end, so parallel tests never see each other's rows.
+package dbtest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"io/fs"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+	"github.com/testcontainers/testcontainers-go/wait"
+
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/pkg/db/migrate"
+)
+
+// StartPostgres runs a Postgres container, applies the given migrations
+// (nil skips them), and returns a pool torn down with the test.
+func StartPostgres(t *testing.T, migrations fs.FS) *pgxpool.Pool {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := tcpostgres.Run(ctx, "postgres:16-alpine",
+		tcpostgres.WithDatabase("infera_test"),
+		tcpostgres.WithUsername("infera"),
+		tcpostgres.WithPassword("infera"),
+		testcontainers.WithWaitStrategy(
+			wait.ForLog("database system is ready to accept connections").
+				WithOccurrence(2).
+				WithStartupTimeout(time.Minute)))
+	if err != nil {
+		t.Fatalf("dbtest: start postgres: %v", err)
+	}
+	t.Cleanup(func() { container.Terminate(context.Background()) })
+
+	dsn, err := container.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		t.Fatalf("dbtest: connection string: %v", err)
+	}
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatalf("dbtest: connect: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	if migrations != nil {
+		if _, err := migrate.Up(ctx, pool, migrations); err != nil {
+			t.Fatalf("dbtest: migrate: %v", err)
+		}
+	}
+	return pool
+}
+
+// StartRedis runs a Redis container and returns its host:port address,
+// torn down with the test.
+func StartRedis(t *testing.T) string {
+	t.Helper()
+	ctx := context.Background()
+
+	container, err := tcredis.Run(ctx, "redis:7-alpine")
+	if err != nil {
+		t.Fatalf("dbtest: start redis: %v", err)
+	}
+	t.Cleanup(func() { container.Terminate(context.Background()) })
+
+	uri, err := container.ConnectionString(ctx)
+	if err != nil {
+		t.Fatalf("dbtest: connection string: %v", err)
+	}
+	return strings.TrimPrefix(uri, "redis://")
+}
+
+// Isolate returns a context bound to one transaction that is rolled
+// back when the test ends. Repositories resolving their querier with
+// db.QuerierFrom run inside it, so tests sharing a container stay
+// independent.
+func Isolate(t *testing.T, pool *pgxpool.Pool) context.Context {
+	t.Helper()
+	ctx := context.Background()
+
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		t.Fatalf("dbtest: begin isolation transaction: %v", err)
+	}
+	t.Cleanup(func() { tx.Rollback(context.Background()) })
+	return db.WithTx(ctx, tx)
+}
@@ -0,0 +1,52 @@
+// Package db holds shared database helpers used by service
+// repositories.
+package db
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// PostgreSQL error codes (class 23: integrity constraint violation).
+const (
+	codeUniqueViolation     = "23505"
+	codeForeignKeyViolation = "23503"
+)
+
+// IsNotFound reports whether err means the query matched no rows.
+// Repositories map it to their domain's not-found sentinel instead of
+// surfacing a generic database error.
+func IsNotFound(err error) bool {
+	return errors.Is(err, pgx.ErrNoRows)
+}
+
+// IsUniqueViolation reports whether err is a unique-constraint
+// violation. Combine with ConstraintName to tell which field collided.
+func IsUniqueViolation(err error) bool {
+	return sqlState(err) == codeUniqueViolation
+}
+
+// IsForeignKeyViolation reports whether err is a foreign-key violation.
+func IsForeignKeyViolation(err error) bool {
+	return sqlState(err) == codeForeignKeyViolation
+}
+
+// ConstraintName returns the name of the violated constraint, or ""
+// when err carries none.
+func ConstraintName(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.ConstraintName
+	}
+	return ""
+}
+
+func sqlState(err error) string {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code
+	}
+	return ""
+}
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// HealthCheck returns a readiness check for the pool:
+//
+//	readiness.Register("postgres", db.HealthCheck(pool))
+//
+// The registry bounds each probe, so a plain Ping is honest enough:
+// pool exhaustion or a dead primary turns the instance unready.
+func HealthCheck(pool *pgxpool.Pool) common.CheckFunc {
+	return func(ctx context.Context) error {
+		if err := pool.Ping(ctx); err != nil {
+			return fmt.Errorf("ping: %w", err)
+		}
+		return nil
+	}
+}
+
+// ReplicaHealthCheck additionally fails when replication lag exceeds
+// maxLag, for pools pointed at a replica.
+func ReplicaHealthCheck(pool *pgxpool.Pool, maxLag time.Duration) common.CheckFunc {
+	return func(ctx context.Context) error {
+		var lagSeconds float64
+		err := pool.QueryRow(ctx, `
+			SELECT COALESCE(EXTRACT(EPOCH FROM (now() - pg_last_xact_replay_timestamp())), 0)`,
+		).Scan(&lagSeconds)
+		if err != nil {
+			return fmt.Errorf("lag probe: %w", err)
+		}
+		if lag := time.Duration(lagSeconds * float64(time.Second)); lag > maxLag {
+			return fmt.Errorf("replication lag %s exceeds %s", lag.Round(time.Millisecond), maxLag)
+		}
+		return nil
+	}
+}
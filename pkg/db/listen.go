@@ -0,0 +1,104 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// NotifyHandler receives one NOTIFY payload. Handlers run on the
+// listener goroutine, so they should hand heavy work off rather than
+// block the stream.
+type NotifyHandler func(ctx context.Context, payload string)
+
+// Listener subscribes to Postgres NOTIFY channels for lightweight
+// near-real-time signals — config invalidation, cache busting — where
+// pulling in the full broker would be overkill. Delivery is best
+// effort: notifications sent while reconnecting are lost, so handlers
+// must treat them as "go look", not as data.
+type Listener struct {
+	pool     *pgxpool.Pool
+	handlers map[string][]NotifyHandler
+	log      *slog.Logger
+}
+
+// NewListener builds a listener over the service's pool; it holds one
+// pool connection while running.
+func NewListener(pool *pgxpool.Pool, log *slog.Logger) *Listener {
+	return &Listener{pool: pool, handlers: make(map[string][]NotifyHandler), log: log}
+}
+
+// Handle registers a handler for one channel. Register everything
+// before Run; the channel set is fixed once listening starts.
+func (l *Listener) Handle(channel string, handler NotifyHandler) {
+	l.handlers[channel] = append(l.handlers[channel], handler)
+}
+
+// Listener reconnect backoff bounds.
+const (
+	listenRetryMin = time.Second
+	listenRetryMax = 30 * time.Second
+)
+
+// Run listens until ctx is canceled, reconnecting with backoff when the
+// connection drops; start it alongside the service's other background
+// workers.
+func (l *Listener) Run(ctx context.Context) {
+	delay := listenRetryMin
+	for {
+		start := time.Now()
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Since(start) > time.Minute {
+			// The connection held for a while; treat the drop as fresh.
+			delay = listenRetryMin
+		}
+		l.log.Warn("db: listener disconnected", "error", err, "retry_in", delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		if delay *= 2; delay > listenRetryMax {
+			delay = listenRetryMax
+		}
+	}
+}
+
+// listen holds one connection, subscribes, and dispatches until the
+// connection or context dies.
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	for channel := range l.handlers {
+		if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			return err
+		}
+	}
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		for _, handler := range l.handlers[notification.Channel] {
+			handler(ctx, notification.Payload)
+		}
+	}
+}
+
+// Notify sends a notification through q, typically inside the
+// transaction that made the change, so listeners only hear about
+// committed state.
+func Notify(ctx context.Context, q Querier, channel, payload string) error {
+	_, err := q.Exec(ctx, "SELECT pg_notify($1, $2)", channel, payload)
+	return err
+}
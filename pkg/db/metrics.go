@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// queryDuration is labeled by logical query name, so a regressing
+// repository method shows on dashboards instead of hiding inside
+// end-to-end latency.
+var queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "infera",
+	Subsystem: "db",
+	Name:      "query_duration_seconds",
+	Help:      "Database query duration by logical query name.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"query"})
+
+type queryNameKey struct{}
+
+// WithQueryName labels the queries under ctx for metrics and the slow
+// log, e.g. db.WithQueryName(ctx, "business.search"). Unlabeled
+// queries fall back to the statement verb, which keeps cardinality
+// bounded either way.
+func WithQueryName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, queryNameKey{}, name)
+}
+
+func queryNameFrom(ctx context.Context, sql string) string {
+	if name, ok := ctx.Value(queryNameKey{}).(string); ok {
+		return name
+	}
+	return spanNameFor(sql)
+}
+
+// defaultSlowQueryThreshold is where a query becomes worth a log line.
+const defaultSlowQueryThreshold = 200 * time.Millisecond
+
+// MetricsTracer implements pgx.QueryTracer with Prometheus histograms
+// and slow-query logging. Combine it with the span Tracer via
+// CombineQueryTracers when wiring the pool.
+type MetricsTracer struct {
+	slowThreshold time.Duration
+	log           *slog.Logger
+}
+
+// NewMetricsTracer builds the tracer; a zero slowThreshold falls back
+// to defaultSlowQueryThreshold.
+func NewMetricsTracer(slowThreshold time.Duration, log *slog.Logger) *MetricsTracer {
+	if slowThreshold <= 0 {
+		slowThreshold = defaultSlowQueryThreshold
+	}
+	return &MetricsTracer{slowThreshold: slowThreshold, log: log}
+}
+
+type queryStartKey struct{}
+
+// queryStart carries the start's view of the query into TraceQueryEnd,
+// which only sees the command tag and error.
+type queryStart struct {
+	at  time.Time
+	sql string
+}
+
+func (t *MetricsTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{at: time.Now(), sql: data.SQL})
+}
+
+func (t *MetricsTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(start.at)
+	name := queryNameFrom(ctx, start.sql)
+	queryDuration.WithLabelValues(name).Observe(elapsed.Seconds())
+	if elapsed >= t.slowThreshold {
+		t.log.Warn("db: slow query",
+			"query", name,
+			"duration", elapsed,
+			"sql", compactSQL(start.sql),
+			"error", data.Err)
+	}
+}
+
+// CombineQueryTracers fans tracer callbacks out to several tracers
+// (spans plus metrics), since a pgx connection takes only one.
+func CombineQueryTracers(tracers ...pgx.QueryTracer) pgx.QueryTracer {
+	return multiTracer(tracers)
+}
+
+type multiTracer []pgx.QueryTracer
+
+func (m multiTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	for _, t := range m {
+		ctx = t.TraceQueryStart(ctx, conn, data)
+	}
+	return ctx
+}
+
+func (m multiTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	for _, t := range m {
+		t.TraceQueryEnd(ctx, conn, data)
+	}
+}
@@ -0,0 +1,274 @@
+// Package migrate applies versioned SQL migrations from an embedded
+// filesystem. Each service embeds its migrations directory and runs
+// Up at startup (or from a CLI); applied versions are tracked in a
+// schema_migrations table with checksums, so an edited historical
+// migration is caught instead of silently diverging environments.
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Migration is one versioned schema change, parsed from
+// NNNN_name.up.sql and an optional matching NNNN_name.down.sql.
+type Migration struct {
+	Version int
+	Name    string
+	UpSQL   string
+	DownSQL string
+	// Checksum is the hex SHA-256 of UpSQL, recorded when applied.
+	Checksum string
+}
+
+// advisoryLockKey serializes migration runners across replicas of one
+// service starting at the same time.
+const advisoryLockKey = 874219046
+
+// Load reads migrations from fsys, in version order. Versions must be
+// unique; gaps are allowed (a migration folded into another during
+// review leaves one).
+func Load(fsys fs.FS) ([]Migration, error) {
+	byVersion := make(map[int]*Migration)
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(p, ".sql") {
+			return err
+		}
+		base := path.Base(p)
+		down := strings.HasSuffix(base, ".down.sql")
+		stem := strings.TrimSuffix(strings.TrimSuffix(base, ".down.sql"), ".up.sql")
+		version, name, ok := splitStem(stem)
+		if !ok {
+			return fmt.Errorf("migrate: bad migration filename %q (want NNNN_name.up.sql)", base)
+		}
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		} else if m.Name != name {
+			return fmt.Errorf("migrate: version %04d has conflicting names %q and %q", version, m.Name, name)
+		}
+		if down {
+			m.DownSQL = string(content)
+		} else {
+			if m.UpSQL != "" {
+				return fmt.Errorf("migrate: duplicate up migration for version %04d", version)
+			}
+			m.UpSQL = string(content)
+			sum := sha256.Sum256(content)
+			m.Checksum = hex.EncodeToString(sum[:])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.UpSQL == "" {
+			return nil, fmt.Errorf("migrate: version %04d has a down migration but no up", m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+func splitStem(stem string) (version int, name string, ok bool) {
+	num, name, found := strings.Cut(stem, "_")
+	if !found || name == "" {
+		return 0, "", false
+	}
+	version, err := strconv.Atoi(num)
+	if err != nil || version <= 0 {
+		return 0, "", false
+	}
+	return version, name, true
+}
+
+// Up applies all pending migrations and returns how many ran. It takes
+// an advisory lock first, so concurrent replicas racing at startup
+// apply each migration exactly once.
+func Up(ctx context.Context, pool *pgxpool.Pool, fsys fs.FS) (int, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return 0, err
+	}
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("migrate: acquire connection: %w", err)
+	}
+	defer conn.Release()
+	if err := lock(ctx, conn, advisoryLockKey); err != nil {
+		return 0, err
+	}
+	defer unlock(conn, advisoryLockKey)
+
+	return runUp(ctx, conn, migrations)
+}
+
+// runUp applies whatever part of migrations the connected schema is
+// missing. The caller holds the advisory lock.
+func runUp(ctx context.Context, conn *pgxpool.Conn, migrations []Migration) (int, error) {
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+
+	ran := 0
+	for _, m := range migrations {
+		if checksum, ok := applied[m.Version]; ok {
+			if checksum != m.Checksum {
+				return ran, fmt.Errorf("migrate: checksum mismatch for %04d_%s: file edited after it was applied", m.Version, m.Name)
+			}
+			continue
+		}
+		if err := applyUp(ctx, conn, m); err != nil {
+			return ran, err
+		}
+		ran++
+	}
+	return ran, nil
+}
+
+// Down rolls back up to steps applied migrations, newest first. Every
+// affected migration must ship a .down.sql.
+func Down(ctx context.Context, pool *pgxpool.Pool, fsys fs.FS, steps int) (int, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return 0, err
+	}
+	byVersion := make(map[int]Migration, len(migrations))
+	for _, m := range migrations {
+		byVersion[m.Version] = m
+	}
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("migrate: acquire connection: %w", err)
+	}
+	defer conn.Release()
+	if err := lock(ctx, conn, advisoryLockKey); err != nil {
+		return 0, err
+	}
+	defer unlock(conn, advisoryLockKey)
+
+	applied, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	versions := make([]int, 0, len(applied))
+	for v := range applied {
+		versions = append(versions, v)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+	ran := 0
+	for _, v := range versions {
+		if ran >= steps {
+			break
+		}
+		m, ok := byVersion[v]
+		if !ok {
+			return ran, fmt.Errorf("migrate: applied version %04d has no migration file", v)
+		}
+		if m.DownSQL == "" {
+			return ran, fmt.Errorf("migrate: %04d_%s has no down migration", m.Version, m.Name)
+		}
+		if err := applyDown(ctx, conn, m); err != nil {
+			return ran, err
+		}
+		ran++
+	}
+	return ran, nil
+}
+
+func lock(ctx context.Context, conn *pgxpool.Conn, key int64) error {
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+		return fmt.Errorf("migrate: acquire advisory lock: %w", err)
+	}
+	return nil
+}
+
+func unlock(conn *pgxpool.Conn, key int64) {
+	// Best effort: the lock also dies with the session.
+	conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", key)
+}
+
+func appliedVersions(ctx context.Context, conn *pgxpool.Conn) (map[int]string, error) {
+	_, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER PRIMARY KEY,
+			name       TEXT NOT NULL,
+			checksum   TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+		)`)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: ensure version table: %w", err)
+	}
+	rows, err := conn.Query(ctx, "SELECT version, checksum FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("migrate: read version table: %w", err)
+	}
+	defer rows.Close()
+
+	applied := make(map[int]string)
+	for rows.Next() {
+		var version int
+		var checksum string
+		if err := rows.Scan(&version, &checksum); err != nil {
+			return nil, err
+		}
+		applied[version] = checksum
+	}
+	return applied, rows.Err()
+}
+
+func applyUp(ctx context.Context, conn *pgxpool.Conn, m Migration) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: begin %04d_%s: %w", m.Version, m.Name, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, m.UpSQL); err != nil {
+		return fmt.Errorf("migrate: apply %04d_%s: %w", m.Version, m.Name, err)
+	}
+	_, err = tx.Exec(ctx,
+		"INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)",
+		m.Version, m.Name, m.Checksum)
+	if err != nil {
+		return fmt.Errorf("migrate: record %04d_%s: %w", m.Version, m.Name, err)
+	}
+	return tx.Commit(ctx)
+}
+
+func applyDown(ctx context.Context, conn *pgxpool.Conn, m Migration) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: begin rollback of %04d_%s: %w", m.Version, m.Name, err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, m.DownSQL); err != nil {
+		return fmt.Errorf("migrate: roll back %04d_%s: %w", m.Version, m.Name, err)
+	}
+	if _, err := tx.Exec(ctx, "DELETE FROM schema_migrations WHERE version = $1", m.Version); err != nil {
+		return fmt.Errorf("migrate: unrecord %04d_%s: %w", m.Version, m.Name, err)
+	}
+	return tx.Commit(ctx)
+}
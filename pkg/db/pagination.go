@@ -0,0 +1,75 @@
+package db
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Pagination conventions: list RPCs take page_size and page_token and
+// return next_page_token (see proto/common/v1/pagination.proto).
+// Offset pagination is fine for shallow backoffice screens; anything a
+// client walks to the end (history, exports) should use keyset tokens,
+// which stay O(1) per page and don't skip or repeat rows when the
+// table moves underneath. Either way the ordering must be stable:
+// created_at plus id as tie-breaker.
+
+// ErrInvalidPageToken means the client sent a token we did not issue.
+// Handlers map it to InvalidArgument.
+var ErrInvalidPageToken = errors.New("db: invalid page token")
+
+// ClampPageSize applies the default and ceiling every list endpoint
+// needs: non-positive sizes become def, oversized ones become max.
+func ClampPageSize(size, def, max int) int {
+	if size <= 0 {
+		return def
+	}
+	if size > max {
+		return max
+	}
+	return size
+}
+
+// Offset converts page/size offset pagination, with page counted
+// from 1.
+func Offset(page, size int) int {
+	if page < 1 {
+		page = 1
+	}
+	return (page - 1) * size
+}
+
+// KeysetToken is the cursor of a keyset-paginated list: the stable sort
+// key (created_at, id) of the last row returned. The query for the next
+// page filters WHERE (created_at, id) < ($1, $2) under a matching
+// ORDER BY.
+type KeysetToken struct {
+	CreatedAt time.Time `json:"c"`
+	ID        uuid.UUID `json:"i"`
+}
+
+// EncodePageToken renders the cursor as an opaque URL-safe string.
+func EncodePageToken(token KeysetToken) string {
+	raw, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodePageToken parses a client-provided token; the empty string is
+// the first page.
+func DecodePageToken(encoded string) (KeysetToken, bool, error) {
+	if encoded == "" {
+		return KeysetToken{}, false, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return KeysetToken{}, false, ErrInvalidPageToken
+	}
+	var token KeysetToken
+	if err := json.Unmarshal(raw, &token); err != nil || token.ID == uuid.Nil {
+		return KeysetToken{}, false, ErrInvalidPageToken
+	}
+	return token, true, nil
+}
@@ -0,0 +1,66 @@
+package db
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PoolStatsCollector exports pgxpool.Stat as Prometheus metrics, so
+// pool exhaustion under load is visible (acquired pinned at max_conns,
+// empty-acquire and wait time climbing) instead of guessed at. Register
+// one per pool:
+//
+//	prometheus.MustRegister(db.NewPoolStatsCollector(pool, "user"))
+type PoolStatsCollector struct {
+	pool *pgxpool.Pool
+
+	totalConns      *prometheus.Desc
+	idleConns       *prometheus.Desc
+	acquiredConns   *prometheus.Desc
+	maxConns        *prometheus.Desc
+	acquires        *prometheus.Desc
+	acquireDuration *prometheus.Desc
+	emptyAcquires   *prometheus.Desc
+}
+
+// NewPoolStatsCollector builds a collector; name distinguishes pools
+// when a service has several (primary plus replicas).
+func NewPoolStatsCollector(pool *pgxpool.Pool, name string) *PoolStatsCollector {
+	labels := prometheus.Labels{"pool": name}
+	desc := func(metric, help string) *prometheus.Desc {
+		return prometheus.NewDesc("infera_db_pool_"+metric, help, nil, labels)
+	}
+	return &PoolStatsCollector{
+		pool:            pool,
+		totalConns:      desc("total_conns", "Connections currently in the pool."),
+		idleConns:       desc("idle_conns", "Idle connections in the pool."),
+		acquiredConns:   desc("acquired_conns", "Connections currently checked out."),
+		maxConns:        desc("max_conns", "Configured pool ceiling."),
+		acquires:        desc("acquires_total", "Successful connection acquires."),
+		acquireDuration: desc("acquire_wait_seconds_total", "Cumulative time spent waiting for a connection."),
+		emptyAcquires:   desc("empty_acquires_total", "Acquires that had to wait because the pool was empty."),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *PoolStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.acquiredConns
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquires
+}
+
+// Collect implements prometheus.Collector.
+func (c *PoolStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+}
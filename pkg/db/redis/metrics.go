@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// StatsCollector exports the client's connection pool stats as
+// Prometheus metrics, mirroring db.PoolStatsCollector for Postgres.
+// Register one per client:
+//
+//	prometheus.MustRegister(redis.NewStatsCollector(client, "gateway"))
+type StatsCollector struct {
+	client *Client
+
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	timeouts   *prometheus.Desc
+}
+
+// NewStatsCollector builds a collector; name distinguishes clients when
+// a service has several.
+func NewStatsCollector(client *Client, name string) *StatsCollector {
+	labels := prometheus.Labels{"client": name}
+	desc := func(metric, help string) *prometheus.Desc {
+		return prometheus.NewDesc("infera_redis_pool_"+metric, help, nil, labels)
+	}
+	return &StatsCollector{
+		client:     client,
+		totalConns: desc("total_conns", "Connections currently in the pool."),
+		idleConns:  desc("idle_conns", "Idle connections in the pool."),
+		staleConns: desc("stale_conns_total", "Connections removed as stale."),
+		hits:       desc("hits_total", "Acquires served by an idle connection."),
+		misses:     desc("misses_total", "Acquires that opened a new connection."),
+		timeouts:   desc("timeouts_total", "Acquires that timed out waiting for a connection."),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *StatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.staleConns
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+}
+
+// Collect implements prometheus.Collector.
+func (c *StatsCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.client.PoolStats()
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stats.TotalConns))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stats.IdleConns))
+	ch <- prometheus.MustNewConstMetric(c.staleConns, prometheus.CounterValue, float64(stats.StaleConns))
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(stats.Timeouts))
+}
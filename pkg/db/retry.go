@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Transient SQLSTATEs: the transaction was a casualty of concurrency,
+// not wrong, so rerunning it is the correct response.
+const (
+	codeSerializationFailure = "40001"
+	codeDeadlockDetected     = "40P01"
+)
+
+// IsTransient reports whether err is worth an automatic retry:
+// serialization failures, deadlocks, and connection-level errors that
+// pgx guarantees never reached the server.
+func IsTransient(err error) bool {
+	switch sqlState(err) {
+	case codeSerializationFailure, codeDeadlockDetected:
+		return true
+	}
+	// Class 08: connection exceptions (reset, dropped by a failover).
+	if strings.HasPrefix(sqlState(err), "08") {
+		return true
+	}
+	return pgconn.SafeToRetry(err)
+}
+
+// Retry bounds and backoff. Attempts are few and delays short: a
+// serialization failure resolves as soon as the competing transaction
+// finishes.
+const (
+	retryAttempts  = 3
+	retryBaseDelay = 50 * time.Millisecond
+)
+
+// Retry runs fn, rerunning it with jittered backoff when it fails
+// transiently, so deadlocks and failovers stop surfacing as
+// user-visible errors. fn must be safe to run again from the top —
+// which transactions are, after their rollback.
+func Retry(ctx context.Context, fn func(ctx context.Context) error) error {
+	for attempt := 0; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !IsTransient(err) || attempt >= retryAttempts-1 || ctx.Err() != nil {
+			return err
+		}
+		delay := time.Duration(rand.Int63n(int64(retryBaseDelay << attempt)))
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrInvalidTenant means the tenant name is not a safe schema
+// identifier; handlers map it to InvalidArgument.
+var ErrInvalidTenant = errors.New("db: invalid tenant name")
+
+// tenantNameRE keeps tenant names usable as schema identifiers without
+// quoting games: lowercase, starting with a letter.
+var tenantNameRE = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+type tenantKey struct{}
+
+// WithTenant binds the tenant whose schema queries under ctx should
+// hit. The name is validated here, at the edge, so the pool hook can
+// trust it.
+func WithTenant(ctx context.Context, tenant string) (context.Context, error) {
+	if !tenantNameRE.MatchString(tenant) {
+		return ctx, ErrInvalidTenant
+	}
+	return context.WithValue(ctx, tenantKey{}, tenant), nil
+}
+
+// TenantFrom returns the tenant bound to ctx, if any.
+func TenantFrom(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// EnableTenancy makes the pool set search_path from the context's
+// tenant on every acquire, so repositories stay tenant-unaware:
+//
+//	poolCfg, _ := pgxpool.ParseConfig(dsn)
+//	db.EnableTenancy(poolCfg, "public")
+//
+// Contexts without a tenant use defaultSchema. The path is reset on
+// release, so a pooled connection never leaks one tenant's schema into
+// another's request.
+func EnableTenancy(cfg *pgxpool.Config, defaultSchema string) {
+	cfg.BeforeAcquire = func(ctx context.Context, conn *pgx.Conn) bool {
+		schema := defaultSchema
+		if tenant, ok := TenantFrom(ctx); ok {
+			schema = tenant
+		}
+		_, err := conn.Exec(ctx, "SET search_path TO "+pgx.Identifier{schema}.Sanitize())
+		return err == nil
+	}
+	cfg.AfterRelease = func(conn *pgx.Conn) bool {
+		_, err := conn.Exec(context.Background(), "RESET search_path")
+		return err == nil
+	}
+}
@@ -0,0 +1,70 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracer implements pgx.QueryTracer: every query becomes a client span
+// under the caller's context, carrying the statement, row count, and
+// duration. Wire it into the pool once
+// (pgxpool.Config.ConnConfig.Tracer = db.NewTracer()) and repositories
+// need no manual spans; an N+1 shows up as a fan of sibling spans.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer builds a query tracer on the global tracer provider.
+func NewTracer() *Tracer {
+	return &Tracer{tracer: otel.Tracer("pkg/db")}
+}
+
+// TraceQueryStart opens the span. Statements are parameterized ($n
+// placeholders), so recording them leaks no values.
+func (t *Tracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = t.tracer.Start(ctx, spanNameFor(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", compactSQL(data.SQL)),
+		))
+	return ctx
+}
+
+// TraceQueryEnd closes the span with the outcome.
+func (t *Tracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	} else {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
+
+// spanNameFor keeps span names low-cardinality: just the statement verb.
+func spanNameFor(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "pg query"
+	}
+	return "pg " + strings.ToUpper(fields[0])
+}
+
+// compactSQL collapses the whitespace of an indented query literal and
+// bounds its length for the span attribute.
+func compactSQL(sql string) string {
+	compact := strings.Join(strings.Fields(sql), " ")
+	const max = 500
+	if len(compact) > max {
+		return compact[:max] + "..."
+	}
+	return compact
+}
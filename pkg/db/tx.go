@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Querier is the query surface shared by *pgxpool.Pool and pgx.Tx.
+// Repositories run their statements through it so the same method works
+// standalone and inside a service-level transaction.
+type Querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+type txKey struct{}
+
+// TxManager runs service operations inside one database transaction, so
+// multi-repository writes (domain rows plus their outbox event) commit
+// or roll back together.
+type TxManager struct {
+	pool *pgxpool.Pool
+}
+
+// NewTxManager builds a transaction manager over the service's pool.
+func NewTxManager(pool *pgxpool.Pool) *TxManager {
+	return &TxManager{pool: pool}
+}
+
+// WithinTx runs fn inside a transaction carried by the context:
+// repositories that resolve their querier with QuerierFrom join it
+// automatically. The transaction commits when fn returns nil and rolls
+// back otherwise. A nested call joins the outer transaction instead of
+// opening its own.
+//
+// Transient failures (serialization, deadlock, dropped connection) are
+// retried by rerunning fn in a fresh transaction, so fn must not carry
+// side effects outside the database.
+func (m *TxManager) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+	return Retry(ctx, func(ctx context.Context) error {
+		return m.runTx(ctx, fn)
+	})
+}
+
+func (m *TxManager) runTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, err := m.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// WithTx binds an existing transaction to the context, as WithinTx does
+// internally. Test helpers use it for per-test isolation; services
+// should prefer WithinTx.
+func WithTx(ctx context.Context, tx pgx.Tx) context.Context {
+	return context.WithValue(ctx, txKey{}, tx)
+}
+
+// QuerierFrom returns the transaction bound to ctx, or fallback when
+// the call is not transactional. Repositories call it at the top of
+// each method instead of using their pool directly.
+func QuerierFrom(ctx context.Context, fallback Querier) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return fallback
+}
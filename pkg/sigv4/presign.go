@@ -0,0 +1,179 @@
+// Package sigv4 implements AWS Signature Version 4 presigning for
+// S3-compatible stores (S3, MinIO) without pulling in an SDK —
+// presigning is pure signature arithmetic. The service storage
+// adapters wrap it; the signing lives here exactly once.
+package sigv4
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrObjectNotFound is returned by Stat for keys with no object behind
+// them — typically a client that confirmed an upload it never made.
+var ErrObjectNotFound = errors.New("sigv4: object not found")
+
+// Config configures the presigner.
+type Config struct {
+	// Endpoint is the storage host, e.g. "minio.internal:9000" or
+	// "s3.ir-thr-1.example.com".
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+}
+
+// ObjectInfo is what Stat reports about a stored object.
+type ObjectInfo struct {
+	ContentType string
+	SizeBytes   int64
+}
+
+// Presigner issues SigV4 presigned URLs and verifies stored objects.
+type Presigner struct {
+	cfg  Config
+	http *http.Client
+	now  func() time.Time
+}
+
+// New builds a presigner.
+func New(cfg Config) *Presigner {
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	return &Presigner{cfg: cfg, http: &http.Client{Timeout: 10 * time.Second}, now: time.Now}
+}
+
+// PresignPut returns a URL that allows exactly one kind of request: a
+// PUT of the object at key with the given Content-Type, valid for
+// expiry. The content type is part of the signature, so the store
+// rejects an upload that declares one type and sends another.
+func (p *Presigner) PresignPut(key, contentType string, expiry time.Duration) (string, error) {
+	return p.presign(http.MethodPut, key, contentType, expiry)
+}
+
+// PresignGet returns a time-limited download URL for the object at key.
+func (p *Presigner) PresignGet(key string, expiry time.Duration) (string, error) {
+	return p.presign(http.MethodGet, key, "", expiry)
+}
+
+// Stat HEADs the object server-side and reports its actual size and
+// content type. Confirm flows call it so validation done at presign
+// time is checked against what really landed in the bucket — a
+// presigned URL cannot constrain the body length.
+func (p *Presigner) Stat(ctx context.Context, key string) (ObjectInfo, error) {
+	signed, err := p.presign(http.MethodHead, key, "", time.Minute)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, signed, nil)
+	if err != nil {
+		return ObjectInfo{}, fmt.Errorf("sigv4: build stat request: %w", err)
+	}
+	resp, err := p.http.Do(req)
+	if err != nil {
+		return ObjectInfo{}, fmt.Errorf("sigv4: stat %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ObjectInfo{}, fmt.Errorf("%w: %s", ErrObjectNotFound, key)
+	case resp.StatusCode != http.StatusOK:
+		return ObjectInfo{}, fmt.Errorf("sigv4: stat %s: storage returned %d", key, resp.StatusCode)
+	}
+	size, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return ObjectInfo{}, fmt.Errorf("sigv4: stat %s: bad Content-Length: %w", key, err)
+	}
+	return ObjectInfo{ContentType: resp.Header.Get("Content-Type"), SizeBytes: size}, nil
+}
+
+func (p *Presigner) presign(method, key, contentType string, expiry time.Duration) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("sigv4: empty object key")
+	}
+	now := p.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	shortDate := now.Format("20060102")
+	scope := strings.Join([]string{shortDate, p.cfg.Region, "s3", "aws4_request"}, "/")
+
+	scheme := "http"
+	if p.cfg.UseSSL {
+		scheme = "https"
+	}
+	// Path-style addressing works for both MinIO and S3.
+	path := "/" + p.cfg.Bucket + "/" + strings.TrimPrefix(key, "/")
+
+	// Canonical headers must be sorted; content-type orders before host.
+	canonicalHeaders := "host:" + p.cfg.Endpoint + "\n"
+	signedHeaders := "host"
+	if contentType != "" {
+		canonicalHeaders = "content-type:" + contentType + "\n" + canonicalHeaders
+		signedHeaders = "content-type;host"
+	}
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", p.cfg.AccessKey+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", fmt.Sprintf("%d", int(expiry.Seconds())))
+	query.Set("X-Amz-SignedHeaders", signedHeaders)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		escapePath(path),
+		query.Encode(),
+		canonicalHeaders,
+		signedHeaders,
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256(canonicalRequest),
+	}, "\n")
+
+	signingKey := hmacSHA256(
+		hmacSHA256(
+			hmacSHA256(
+				hmacSHA256([]byte("AWS4"+p.cfg.SecretKey), shortDate),
+				p.cfg.Region),
+			"s3"),
+		"aws4_request")
+	query.Set("X-Amz-Signature", hex.EncodeToString(hmacSHA256(signingKey, stringToSign)))
+
+	return scheme + "://" + p.cfg.Endpoint + escapePath(path) + "?" + query.Encode(), nil
+}
+
+// escapePath URI-encodes each path segment the way SigV4 expects.
+func escapePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func hexSHA256(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
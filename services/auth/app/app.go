@@ -0,0 +1,65 @@
+// Package app wires the auth service together and owns the lifecycle of
+// its external connections.
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/K-H-Tech/infera/services/auth/store"
+)
+
+// App holds the auth service's long-lived resources. OnStart establishes
+// connections; OnStop releases them in reverse order.
+type App struct {
+	cfg Config
+	log *slog.Logger
+
+	redis redis.UniversalClient
+
+	// Stores handed to the service layer at wiring time.
+	OTPStore       *store.RedisOTPStore
+	CounterStore   *store.RedisCounterStore
+	RevocationList *store.RevocationList
+}
+
+// New builds the app shell; nothing connects until OnStart.
+func New(cfg Config, log *slog.Logger) *App {
+	return &App{cfg: cfg, log: log}
+}
+
+// OnStart connects to Redis and verifies the connection before any
+// traffic is served, so a misconfigured address fails the deploy instead
+// of every login.
+func (a *App) OnStart(ctx context.Context) error {
+	client := redis.NewUniversalClient(&redis.UniversalOptions{
+		Addrs:    []string{a.cfg.Redis.Addr},
+		Password: a.cfg.Redis.Password,
+		DB:       a.cfg.Redis.DB,
+		PoolSize: a.cfg.Redis.PoolSize,
+	})
+	if err := client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("auth: redis ping: %w", err)
+	}
+	a.redis = client
+	a.OTPStore = store.NewRedisOTPStore(client)
+	a.CounterStore = store.NewRedisCounterStore(client)
+	a.RevocationList = store.NewRevocationList(client)
+	a.log.Info("auth: redis connected", "addr", a.cfg.Redis.Addr)
+	return nil
+}
+
+// OnStop closes the Redis connection.
+func (a *App) OnStop(ctx context.Context) error {
+	if a.redis == nil {
+		return nil
+	}
+	if err := a.redis.Close(); err != nil {
+		return fmt.Errorf("auth: redis close: %w", err)
+	}
+	a.log.Info("auth: redis connection closed")
+	return nil
+}
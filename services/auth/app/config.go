@@ -0,0 +1,44 @@
+package app
+
+import "time"
+
+// Config is the auth service configuration, loaded from YAML and
+// environment overrides at startup.
+type Config struct {
+	// Redis backs OTP storage, throttle counters, and the revocation
+	// list.
+	Redis RedisConfig `yaml:"redis"`
+
+	// OTPTTL bounds how long a login code stays valid.
+	OTPTTL time.Duration `yaml:"otp_ttl"`
+	// RefreshTTL bounds refresh-token sessions for clients without a
+	// dedicated entry in Clients.
+	RefreshTTL time.Duration `yaml:"refresh_ttl"`
+	// Clients overrides token lifetimes per client type (mobile-app,
+	// web-dashboard, backoffice, m2m).
+	Clients map[string]ClientTokensConfig `yaml:"clients"`
+}
+
+// ClientTokensConfig is one client type's token lifetime overrides.
+type ClientTokensConfig struct {
+	AccessTTL  time.Duration `yaml:"access_ttl"`
+	RefreshTTL time.Duration `yaml:"refresh_ttl"`
+}
+
+// RedisConfig is the auth service's Redis section.
+type RedisConfig struct {
+	Addr     string `yaml:"addr"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+	PoolSize int    `yaml:"pool_size"`
+}
+
+// DefaultConfig returns the defaults applied before the file and
+// environment are read.
+func DefaultConfig() Config {
+	return Config{
+		Redis:      RedisConfig{Addr: "localhost:6379"},
+		OTPTTL:     2 * time.Minute,
+		RefreshTTL: 30 * 24 * time.Hour,
+	}
+}
@@ -0,0 +1,401 @@
+// Package authtest provides in-process harnesses for exercising the auth
+// service's flows. NewHarness runs everything over in-memory fakes for
+// fast flow-level tests: tokens, claims, throttling, state transitions.
+// NewInfraHarness swaps in the real Postgres repositories and Redis
+// stores over throwaway containers, so the same flows also cover the
+// store implementations.
+package authtest
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+	"github.com/K-H-Tech/infera/services/auth/service"
+	"github.com/K-H-Tech/infera/services/auth/token"
+)
+
+// FakeNotifier records outgoing messages and exposes the last OTP per
+// mobile so tests can complete the login loop.
+type FakeNotifier struct {
+	mu    sync.Mutex
+	codes map[string]string
+	sent  []string
+}
+
+// NewFakeNotifier builds an empty notifier.
+func NewFakeNotifier() *FakeNotifier {
+	return &FakeNotifier{codes: make(map[string]string)}
+}
+
+func (f *FakeNotifier) SendOTP(_ context.Context, mobile, code string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.codes[mobile] = code
+	f.sent = append(f.sent, mobile)
+	return nil
+}
+
+func (f *FakeNotifier) SendMobileChanged(_ context.Context, mobile, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, mobile)
+	return nil
+}
+
+// LastCode returns the most recent OTP delivered to the mobile.
+func (f *FakeNotifier) LastCode(mobile string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.codes[mobile]
+}
+
+// SentCount returns how many messages went out.
+func (f *FakeNotifier) SentCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.sent)
+}
+
+// MemOTPStore is an in-memory domain.OTPStore with expiry.
+type MemOTPStore struct {
+	mu      sync.Mutex
+	entries map[string]memOTP
+}
+
+type memOTP struct {
+	code      string
+	expiresAt time.Time
+}
+
+// NewMemOTPStore builds an empty store.
+func NewMemOTPStore() *MemOTPStore {
+	return &MemOTPStore{entries: make(map[string]memOTP)}
+}
+
+func (s *MemOTPStore) Set(_ context.Context, mobile, code string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[mobile] = memOTP{code: code, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+
+func (s *MemOTPStore) Get(_ context.Context, mobile string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[mobile]
+	if !ok || time.Now().After(e.expiresAt) {
+		return "", domain.ErrOTPExpired
+	}
+	return e.code, nil
+}
+
+func (s *MemOTPStore) Delete(_ context.Context, mobile string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, mobile)
+	return nil
+}
+
+// MemCounterStore is an in-memory domain.CounterStore.
+type MemCounterStore struct {
+	mu       sync.Mutex
+	counters map[string]int64
+}
+
+// NewMemCounterStore builds an empty store.
+func NewMemCounterStore() *MemCounterStore {
+	return &MemCounterStore{counters: make(map[string]int64)}
+}
+
+func (s *MemCounterStore) Incr(_ context.Context, key string, _ time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key]++
+	return s.counters[key], nil
+}
+
+func (s *MemCounterStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.counters, key)
+	return nil
+}
+
+// MemUserRepo is an in-memory domain.UserRepository.
+type MemUserRepo struct {
+	mu    sync.Mutex
+	users map[uuid.UUID]*domain.User
+}
+
+// NewMemUserRepo builds an empty repository.
+func NewMemUserRepo() *MemUserRepo {
+	return &MemUserRepo{users: make(map[uuid.UUID]*domain.User)}
+}
+
+func (r *MemUserRepo) GetByID(_ context.Context, id uuid.UUID) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if u, ok := r.users[id]; ok {
+		clone := *u
+		return &clone, nil
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *MemUserRepo) GetByMobile(_ context.Context, mobile string) (*domain.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if u.Mobile == mobile {
+			clone := *u
+			return &clone, nil
+		}
+	}
+	return nil, domain.ErrUserNotFound
+}
+
+func (r *MemUserRepo) Create(_ context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clone := *user
+	r.users[user.ID] = &clone
+	return nil
+}
+
+func (r *MemUserRepo) Update(_ context.Context, user *domain.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.users[user.ID]; !ok {
+		return domain.ErrUserNotFound
+	}
+	clone := *user
+	r.users[user.ID] = &clone
+	return nil
+}
+
+// MemSessionRepo is an in-memory domain.SessionRepository.
+type MemSessionRepo struct {
+	mu       sync.Mutex
+	sessions map[uuid.UUID]*domain.Session
+}
+
+// NewMemSessionRepo builds an empty repository.
+func NewMemSessionRepo() *MemSessionRepo {
+	return &MemSessionRepo{sessions: make(map[uuid.UUID]*domain.Session)}
+}
+
+func (r *MemSessionRepo) Create(_ context.Context, session *domain.Session) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clone := *session
+	r.sessions[session.ID] = &clone
+	return nil
+}
+
+func (r *MemSessionRepo) GetByTokenHash(_ context.Context, hash string) (*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.sessions {
+		if s.RefreshTokenHash == hash {
+			clone := *s
+			return &clone, nil
+		}
+	}
+	return nil, domain.ErrSessionNotFound
+}
+
+func (r *MemSessionRepo) ListByUser(_ context.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*domain.Session
+	for _, s := range r.sessions {
+		if s.UserID == userID {
+			clone := *s
+			out = append(out, &clone)
+		}
+	}
+	return out, nil
+}
+
+func (r *MemSessionRepo) ListActiveIssuedBefore(_ context.Context, before time.Time, limit int) ([]*domain.Session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []*domain.Session
+	for _, s := range r.sessions {
+		if s.IssuedAt.Before(before) && s.RevokedAt == nil && s.ExpiresAt.After(time.Now()) {
+			clone := *s
+			out = append(out, &clone)
+			if len(out) == limit {
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
+func (r *MemSessionRepo) Revoke(_ context.Context, id uuid.UUID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.sessions[id]
+	if !ok || s.RevokedAt != nil {
+		return domain.ErrSessionNotFound
+	}
+	s.RevokedAt = &at
+	return nil
+}
+
+func (r *MemSessionRepo) RevokeAllForUser(_ context.Context, userID uuid.UUID, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, s := range r.sessions {
+		if s.UserID == userID && s.RevokedAt == nil {
+			s.RevokedAt = &at
+		}
+	}
+	return nil
+}
+
+// MemAuditRepo is an in-memory domain.AuditRepository.
+type MemAuditRepo struct {
+	mu     sync.Mutex
+	events []*domain.AuditEvent
+}
+
+// NewMemAuditRepo builds an empty repository.
+func NewMemAuditRepo() *MemAuditRepo {
+	return &MemAuditRepo{}
+}
+
+func (r *MemAuditRepo) Insert(_ context.Context, event *domain.AuditEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clone := *event
+	r.events = append(r.events, &clone)
+	return nil
+}
+
+func (r *MemAuditRepo) List(_ context.Context, filter domain.AuditFilter, page domain.AuditPage) ([]*domain.AuditEvent, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var matched []*domain.AuditEvent
+	for _, e := range r.events {
+		if filter.UserID != nil && (e.UserID == nil || *e.UserID != *filter.UserID) {
+			continue
+		}
+		if filter.Mobile != "" && e.Mobile != filter.Mobile {
+			continue
+		}
+		matched = append(matched, e)
+	}
+	total := int64(len(matched))
+	if page.Offset >= len(matched) {
+		return nil, total, nil
+	}
+	end := page.Offset + page.Limit
+	if end > len(matched) {
+		end = len(matched)
+	}
+	return matched[page.Offset:end], total, nil
+}
+
+// Events returns a copy of everything recorded so far.
+func (r *MemAuditRepo) Events() []*domain.AuditEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*domain.AuditEvent(nil), r.events...)
+}
+
+// MemEventSink collects published domain events.
+type MemEventSink struct {
+	mu     sync.Mutex
+	events []domain.Event
+}
+
+// NewMemEventSink builds an empty sink.
+func NewMemEventSink() *MemEventSink {
+	return &MemEventSink{}
+}
+
+func (s *MemEventSink) Publish(_ context.Context, event domain.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, event)
+	return nil
+}
+
+// Names returns the published event names in order.
+func (s *MemEventSink) Names() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := make([]string, len(s.events))
+	for i, e := range s.events {
+		names[i] = e.Name
+	}
+	return names
+}
+
+// Harness is a fully wired in-process auth service over fakes.
+type Harness struct {
+	Auth     *service.AuthService
+	Audit    *service.AuditService
+	Notifier *FakeNotifier
+	Users    *MemUserRepo
+	Sessions *MemSessionRepo
+	AuditLog *MemAuditRepo
+	Events   *MemEventSink
+	Throttle service.ThrottleConfig
+}
+
+// NewHarness wires the OTP flow with in-memory components. The throttle
+// config is deliberately tight so tests can reach lockouts quickly.
+func NewHarness() *Harness {
+	log := slog.Default()
+	notifier := NewFakeNotifier()
+	users := NewMemUserRepo()
+	sessions := NewMemSessionRepo()
+	auditRepo := NewMemAuditRepo()
+	events := NewMemEventSink()
+	audit := service.NewAuditService(auditRepo, log)
+	throttleCfg := service.ThrottleConfig{
+		LoginPerMobile:  3,
+		LoginPerIP:      10,
+		VerifyPerMobile: 3,
+		VerifyPerIP:     10,
+		Window:          time.Minute,
+	}
+	throttle := service.NewLoginThrottle(NewMemCounterStore(), throttleCfg, audit, log)
+	captcha := service.NewCaptchaPolicy(service.CaptchaPolicyConfig{}, nil, nil, NewMemCounterStore(), log)
+	issuer := token.NewJWTIssuer([]byte("test-secret"), "infera-test", 15*time.Minute, nil)
+
+	auth := service.NewAuthService(service.AuthServiceParams{
+		Users:    users,
+		Sessions: sessions,
+		OTP:      NewMemOTPStore(),
+		Issuer:   issuer,
+		Notifier: notifier,
+		Audit:    audit,
+		Throttle: throttle,
+		Captcha:  captcha,
+		Events:   events,
+		Log:      log,
+		OTPTTL:   2 * time.Minute,
+		Lifetimes: service.ClientLifetimes{
+			"default": {Access: 15 * time.Minute, Refresh: time.Hour},
+		},
+	})
+	return &Harness{
+		Auth:     auth,
+		Audit:    audit,
+		Notifier: notifier,
+		Users:    users,
+		Sessions: sessions,
+		AuditLog: auditRepo,
+		Events:   events,
+		Throttle: throttleCfg,
+	}
+}
@@ -0,0 +1,85 @@
+package authtest
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/K-H-Tech/infera/pkg/db/dbtest"
+	"github.com/K-H-Tech/infera/services/auth/domain"
+	"github.com/K-H-Tech/infera/services/auth/migrations"
+	"github.com/K-H-Tech/infera/services/auth/repository"
+	"github.com/K-H-Tech/infera/services/auth/service"
+	"github.com/K-H-Tech/infera/services/auth/store"
+	"github.com/K-H-Tech/infera/services/auth/token"
+)
+
+// InfraHarness is the auth service wired over real infrastructure:
+// migrated Postgres repositories and the Redis OTP, counter, and
+// revocation stores, in throwaway containers. The notifier and event
+// sink stay fakes — they front external systems, not ours. Use
+// NewHarness when only flow logic is under test; this variant is for
+// proving the store implementations behave like the fakes.
+type InfraHarness struct {
+	Auth     *service.AuthService
+	Audit    *service.AuditService
+	Notifier *FakeNotifier
+	Users    domain.UserRepository
+	Sessions domain.SessionRepository
+	Events   *MemEventSink
+	Throttle service.ThrottleConfig
+}
+
+// NewInfraHarness starts the containers and wires the service; both are
+// torn down with the test.
+func NewInfraHarness(t *testing.T) *InfraHarness {
+	t.Helper()
+	pool := dbtest.StartPostgres(t, migrations.FS)
+	client := redis.NewClient(&redis.Options{Addr: dbtest.StartRedis(t)})
+	t.Cleanup(func() { client.Close() })
+
+	log := slog.Default()
+	notifier := NewFakeNotifier()
+	users := repository.NewUserRepository(pool)
+	sessions := repository.NewSessionRepository(pool)
+	events := NewMemEventSink()
+	audit := service.NewAuditService(repository.NewAuditRepository(pool), log)
+	throttleCfg := service.ThrottleConfig{
+		LoginPerMobile:  3,
+		LoginPerIP:      10,
+		VerifyPerMobile: 3,
+		VerifyPerIP:     10,
+		Window:          time.Minute,
+	}
+	throttle := service.NewLoginThrottle(store.NewRedisCounterStore(client), throttleCfg, audit, log)
+	captcha := service.NewCaptchaPolicy(service.CaptchaPolicyConfig{}, nil, nil, store.NewRedisCounterStore(client), log)
+	issuer := token.NewJWTIssuer([]byte("test-secret"), "infera-test", 15*time.Minute, nil)
+
+	auth := service.NewAuthService(service.AuthServiceParams{
+		Users:    users,
+		Sessions: sessions,
+		OTP:      store.NewRedisOTPStore(client),
+		Issuer:   issuer,
+		Notifier: notifier,
+		Audit:    audit,
+		Throttle: throttle,
+		Captcha:  captcha,
+		Events:   events,
+		Log:      log,
+		OTPTTL:   2 * time.Minute,
+		Lifetimes: service.ClientLifetimes{
+			"default": {Access: 15 * time.Minute, Refresh: time.Hour},
+		},
+	})
+	return &InfraHarness{
+		Auth:     auth,
+		Audit:    audit,
+		Notifier: notifier,
+		Users:    users,
+		Sessions: sessions,
+		Events:   events,
+		Throttle: throttleCfg,
+	}
+}
@@ -0,0 +1,63 @@
+// Package clients holds the auth service's typed clients to sibling
+// services.
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+)
+
+// Logical template names auth uses; the notification service maps them to
+// provider templates per environment.
+const (
+	TemplateOTPLogin      = "otp-login"
+	TemplateMobileChanged = "mobile-changed"
+)
+
+// Notification implements service.NotificationClient over the notification
+// service's gRPC API.
+type Notification struct {
+	client notificationv1.NotificationServiceClient
+	locale string
+}
+
+// NewNotification builds the client. locale is the default locale for
+// auth-originated messages.
+func NewNotification(conn grpc.ClientConnInterface, locale string) *Notification {
+	return &Notification{
+		client: notificationv1.NewNotificationServiceClient(conn),
+		locale: locale,
+	}
+}
+
+// SendOTP delivers a login code through the otp-login template.
+func (n *Notification) SendOTP(ctx context.Context, mobile, code string) error {
+	_, err := n.client.SendOTP(ctx, &notificationv1.SendOTPRequest{
+		Mobile:   mobile,
+		Code:     code,
+		Template: TemplateOTPLogin,
+		Locale:   n.locale,
+	})
+	if err != nil {
+		return fmt.Errorf("notification SendOTP: %w", err)
+	}
+	return nil
+}
+
+// SendMobileChanged notifies a number about a completed mobile change.
+func (n *Notification) SendMobileChanged(ctx context.Context, mobile, newMobile string) error {
+	_, err := n.client.SendSMS(ctx, &notificationv1.SendSMSRequest{
+		Mobile:   mobile,
+		Template: TemplateMobileChanged,
+		Locale:   n.locale,
+		Params:   map[string]string{"token": newMobile},
+	})
+	if err != nil {
+		return fmt.Errorf("notification SendSMS: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEventType enumerates the authentication events we keep a trail of.
+type AuditEventType string
+
+const (
+	AuditLoginRequested AuditEventType = "login_requested"
+	AuditLoginSucceeded AuditEventType = "login_succeeded"
+	AuditOTPRejected    AuditEventType = "otp_rejected"
+	AuditLockout        AuditEventType = "lockout"
+	AuditTokenRefreshed AuditEventType = "token_refreshed"
+	AuditLogout         AuditEventType = "logout"
+	AuditImpersonation  AuditEventType = "impersonation"
+	AuditMobileChanged  AuditEventType = "mobile_changed"
+)
+
+// AuditEvent is one row in the login audit trail. UserID is nil for events
+// that happen before an identity is resolved (e.g. a rejected OTP for an
+// unknown mobile).
+type AuditEvent struct {
+	ID        uuid.UUID
+	UserID    *uuid.UUID
+	Mobile    string
+	Type      AuditEventType
+	IP        string
+	UserAgent string
+	// GeoHint is a coarse location derived from the IP at ingest time
+	// (country/city), kept as free text so we are not coupled to one
+	// geo provider's schema.
+	GeoHint   string
+	Metadata  map[string]string
+	CreatedAt time.Time
+}
+
+// AuditFilter narrows an audit query. Zero values mean "no constraint".
+type AuditFilter struct {
+	UserID *uuid.UUID
+	Mobile string
+	Types  []AuditEventType
+	From   time.Time
+	To     time.Time
+}
+
+// AuditPage is a limit/offset window over the audit trail, newest first.
+type AuditPage struct {
+	Limit  int
+	Offset int
+}
+
+// AuditRepository persists and queries the login audit trail.
+type AuditRepository interface {
+	Insert(ctx context.Context, event *AuditEvent) error
+	List(ctx context.Context, filter AuditFilter, page AuditPage) ([]*AuditEvent, int64, error)
+}
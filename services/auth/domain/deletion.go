@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrTombstoneNotFound is returned when no deletion record exists.
+var ErrTombstoneNotFound = errors.New("auth: tombstone not found")
+
+// AccountTombstone is what remains of a deleted account: enough to audit
+// the deletion and to recognize a returning mobile, without retaining the
+// personal data itself.
+type AccountTombstone struct {
+	UserID uuid.UUID
+	// MobileHash is a keyed hash of the erased mobile, kept so support can
+	// confirm "this number had an account that was deleted" without
+	// storing the number.
+	MobileHash string
+	Reason     string
+	DeletedAt  time.Time
+}
+
+// TombstoneRepository persists deletion tombstones.
+type TombstoneRepository interface {
+	Create(ctx context.Context, tombstone *AccountTombstone) error
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*AccountTombstone, error)
+}
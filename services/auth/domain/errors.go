@@ -0,0 +1,31 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrUserNotFound is returned when no auth identity exists for the lookup.
+	ErrUserNotFound = errors.New("auth: user not found")
+
+	// ErrInvalidOTP is returned when the submitted code does not match.
+	ErrInvalidOTP = errors.New("auth: invalid otp")
+
+	// ErrOTPExpired is returned when no code is pending for the mobile.
+	ErrOTPExpired = errors.New("auth: otp expired or not requested")
+
+	// ErrSessionNotFound is returned for unknown refresh tokens.
+	ErrSessionNotFound = errors.New("auth: session not found")
+
+	// ErrSessionRevoked is returned when a refresh token was revoked.
+	ErrSessionRevoked = errors.New("auth: session revoked")
+
+	// ErrSessionExpired is returned when a refresh token is past its expiry.
+	ErrSessionExpired = errors.New("auth: session expired")
+
+	// ErrCaptchaRequired is returned when risk signals demand a captcha
+	// and the request did not carry one.
+	ErrCaptchaRequired = errors.New("auth: captcha required")
+
+	// ErrCaptchaInvalid is returned when the supplied captcha token fails
+	// verification.
+	ErrCaptchaInvalid = errors.New("auth: captcha invalid")
+)
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Auth domain event names. Other services key their consumers on these.
+const (
+	EventUserRegistered = "user.registered"
+	EventUserLoggedIn   = "user.logged_in"
+	EventSessionRevoked = "session.revoked"
+	EventAccountBlocked = "account.blocked"
+	EventAccountDeleted = "auth.account.deleted"
+)
+
+// Event is one auth domain event handed to the publisher.
+type Event struct {
+	Name       string
+	UserID     uuid.UUID
+	OccurredAt time.Time
+	Payload    map[string]string
+}
+
+// EventPublisher emits auth domain events for other services (user,
+// customer, notification, analytics) to consume asynchronously.
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
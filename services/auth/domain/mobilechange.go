@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MobileChangeStatus is the lifecycle of a mobile-change request.
+type MobileChangeStatus string
+
+const (
+	// MobileChangePending waits for OTP confirmation on both numbers.
+	MobileChangePending MobileChangeStatus = "pending"
+	// MobileChangeConfirmed has both confirmations; the change applies
+	// once the grace period has elapsed.
+	MobileChangeConfirmed MobileChangeStatus = "confirmed"
+	// MobileChangeApplied means the auth identity now uses the new number.
+	MobileChangeApplied MobileChangeStatus = "applied"
+	// MobileChangeCancelled was aborted by the user before it applied.
+	MobileChangeCancelled MobileChangeStatus = "cancelled"
+)
+
+// Which number a confirmation code was sent to.
+const (
+	MobileChangeTargetOld = "old"
+	MobileChangeTargetNew = "new"
+)
+
+var (
+	// ErrMobileChangeNotFound is returned for unknown or foreign requests.
+	ErrMobileChangeNotFound = errors.New("auth: mobile change request not found")
+
+	// ErrMobileChangeState is returned when an operation does not fit the
+	// request's current status (e.g. applying before both confirmations).
+	ErrMobileChangeState = errors.New("auth: mobile change request in wrong state")
+
+	// ErrMobileInUse is returned when the requested new number already
+	// belongs to another account.
+	ErrMobileInUse = errors.New("auth: mobile already in use")
+)
+
+// MobileChange is a pending request to move an auth identity to a new
+// mobile number. Both the old and the new number must confirm via OTP, and
+// the change only applies after a grace period so a hijacked request can
+// still be cancelled from the old number.
+type MobileChange struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	OldMobile      string
+	NewMobile      string
+	Status         MobileChangeStatus
+	OldConfirmedAt *time.Time
+	NewConfirmedAt *time.Time
+	// EffectiveAt is set when both sides confirm; the change may be
+	// applied at or after this instant.
+	EffectiveAt *time.Time
+	// PropagatedAt records when the new number reached the user
+	// service; nil on an applied change means propagation is still
+	// owed and the background applier keeps retrying it.
+	PropagatedAt *time.Time
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+// MobileChangeRepository persists mobile-change requests.
+type MobileChangeRepository interface {
+	Create(ctx context.Context, change *MobileChange) error
+	GetByID(ctx context.Context, id uuid.UUID) (*MobileChange, error)
+	GetPendingByUser(ctx context.Context, userID uuid.UUID) (*MobileChange, error)
+	Update(ctx context.Context, change *MobileChange) error
+	// ListApplicable returns confirmed requests whose grace period has
+	// elapsed, for the background applier.
+	ListApplicable(ctx context.Context, now time.Time, limit int) ([]*MobileChange, error)
+	// ListUnpropagated returns applied requests whose new number has
+	// not reached the user service yet, for the background applier to
+	// retry.
+	ListUnpropagated(ctx context.Context, limit int) ([]*MobileChange, error)
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// OTPStore keeps one-time passwords between LoginByMobile and VerifyOTP.
+// Implementations must expire entries after the given TTL.
+type OTPStore interface {
+	Set(ctx context.Context, mobile, code string, ttl time.Duration) error
+	Get(ctx context.Context, mobile string) (string, error)
+	Delete(ctx context.Context, mobile string) error
+}
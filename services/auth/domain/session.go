@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Session represents an issued refresh token. Access tokens are stateless;
+// refresh tokens are tracked so they can be revoked individually.
+type Session struct {
+	ID               uuid.UUID
+	UserID           uuid.UUID
+	RefreshTokenHash string
+	ClientID         string
+	IP               string
+	UserAgent        string
+	IssuedAt         time.Time
+	ExpiresAt        time.Time
+	RevokedAt        *time.Time
+}
+
+// Revoked reports whether the session has been explicitly revoked.
+func (s *Session) Revoked() bool { return s.RevokedAt != nil }
+
+// SessionRepository persists refresh-token sessions.
+type SessionRepository interface {
+	Create(ctx context.Context, session *Session) error
+	GetByTokenHash(ctx context.Context, hash string) (*Session, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Session, error)
+	// ListActiveIssuedBefore pages through unrevoked, unexpired sessions
+	// issued before the cutoff, for bulk expiry after secret rotations.
+	ListActiveIssuedBefore(ctx context.Context, before time.Time, limit int) ([]*Session, error)
+	Revoke(ctx context.Context, id uuid.UUID, at time.Time) error
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID, at time.Time) error
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AccountStatus is the lifecycle state of an auth identity.
+type AccountStatus string
+
+const (
+	// StatusPending is a registered account that has not completed its
+	// first verified login.
+	StatusPending AccountStatus = "pending"
+	// StatusActive is a normal, usable account.
+	StatusActive AccountStatus = "active"
+	// StatusSuspended is a temporary operator-imposed hold.
+	StatusSuspended AccountStatus = "suspended"
+	// StatusBlocked is a hard block; token issuance must fail and existing
+	// sessions must be revoked.
+	StatusBlocked AccountStatus = "blocked"
+	// StatusDeleted is terminal; set by the account deletion flow.
+	StatusDeleted AccountStatus = "deleted"
+)
+
+// ErrAccountDisabled is returned when a suspended, blocked, or deleted
+// account attempts to authenticate or refresh.
+var ErrAccountDisabled = errors.New("auth: account disabled")
+
+// ErrInvalidStatusTransition is returned when a requested status change is
+// not allowed by the state machine.
+var ErrInvalidStatusTransition = errors.New("auth: invalid status transition")
+
+// statusTransitions is the allowed edge set of the account state machine.
+// Deleted is terminal.
+var statusTransitions = map[AccountStatus][]AccountStatus{
+	StatusPending:   {StatusActive, StatusBlocked, StatusDeleted},
+	StatusActive:    {StatusSuspended, StatusBlocked, StatusDeleted},
+	StatusSuspended: {StatusActive, StatusBlocked, StatusDeleted},
+	StatusBlocked:   {StatusActive, StatusDeleted},
+}
+
+// Valid reports whether s is a known status value.
+func (s AccountStatus) Valid() bool {
+	switch s {
+	case StatusPending, StatusActive, StatusSuspended, StatusBlocked, StatusDeleted:
+		return true
+	}
+	return false
+}
+
+// CanAuthenticate reports whether an account in this status may obtain
+// tokens. Pending accounts may log in; the first verified login activates
+// them.
+func (s AccountStatus) CanAuthenticate() bool {
+	return s == StatusPending || s == StatusActive
+}
+
+// TransitionTo moves the user to the target status, enforcing the state
+// machine. It does not persist; callers save the user afterwards.
+func (u *User) TransitionTo(target AccountStatus) error {
+	if !target.Valid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidStatusTransition, target)
+	}
+	if u.Status == target {
+		return nil
+	}
+	for _, allowed := range statusTransitions[u.Status] {
+		if allowed == target {
+			u.Status = target
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, u.Status, target)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTooManyAttempts is returned when a mobile or IP exceeds its attempt
+// budget. Handlers map it to ResourceExhausted without leaking which
+// dimension tripped.
+var ErrTooManyAttempts = errors.New("auth: too many attempts")
+
+// CounterStore is a rolling counter with expiry, used for attempt
+// throttling. Incr creates the counter with the window as TTL on first use
+// and returns the value after incrementing.
+type CounterStore interface {
+	Incr(ctx context.Context, key string, window time.Duration) (int64, error)
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User is the authentication identity. Profile data lives in the user
+// service; auth only keeps what it needs to issue and verify tokens.
+type User struct {
+	ID        uuid.UUID
+	Mobile    string
+	Status    AccountStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// UserRepository persists auth identities.
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*User, error)
+	GetByMobile(ctx context.Context, mobile string) (*User, error)
+	Create(ctx context.Context, user *User) error
+	Update(ctx context.Context, user *User) error
+}
@@ -0,0 +1,68 @@
+// Package enrichers contains the claims enrichers registered on the auth
+// service's token issuance pipeline.
+package enrichers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// CustomerLookup is the slice of the customer service used for enrichment.
+type CustomerLookup interface {
+	GetCustomerID(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// CustomerID adds the user's customer_id claim so downstream services can
+// authorize customer resources without a lookup per request.
+type CustomerID struct {
+	customers CustomerLookup
+}
+
+// NewCustomerID builds the customer_id enricher.
+func NewCustomerID(customers CustomerLookup) *CustomerID {
+	return &CustomerID{customers: customers}
+}
+
+func (e *CustomerID) Name() string { return "customer_id" }
+
+func (e *CustomerID) Enrich(ctx context.Context, user *domain.User, extra map[string]any) error {
+	id, err := e.customers.GetCustomerID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		extra["customer_id"] = id
+	}
+	return nil
+}
+
+// KYCLookup is the slice of the user service used for enrichment.
+type KYCLookup interface {
+	GetKYCLevel(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// KYCLevel adds the kyc_level claim from the user service.
+type KYCLevel struct {
+	users KYCLookup
+}
+
+// NewKYCLevel builds the kyc_level enricher.
+func NewKYCLevel(users KYCLookup) *KYCLevel {
+	return &KYCLevel{users: users}
+}
+
+func (e *KYCLevel) Name() string { return "kyc_level" }
+
+func (e *KYCLevel) Enrich(ctx context.Context, user *domain.User, extra map[string]any) error {
+	level, err := e.users.GetKYCLevel(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if level != "" {
+		extra["kyc_level"] = level
+	}
+	return nil
+}
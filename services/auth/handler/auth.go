@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	authv1 "github.com/K-H-Tech/infera/proto/gen/go/auth/v1"
+	"github.com/K-H-Tech/infera/services/auth/domain"
+	"github.com/K-H-Tech/infera/services/auth/service"
+)
+
+// AuthHandler adapts AuthService to the auth.v1 gRPC API.
+type AuthHandler struct {
+	authv1.UnimplementedAuthServiceServer
+
+	auth         *service.AuthService
+	audit        *service.AuditService
+	mobileChange *service.MobileChangeService
+	deletion     *service.DeletionService
+}
+
+// NewAuthHandler builds the public auth handler.
+func NewAuthHandler(auth *service.AuthService, audit *service.AuditService, mobileChange *service.MobileChangeService, deletion *service.DeletionService) *AuthHandler {
+	return &AuthHandler{auth: auth, audit: audit, mobileChange: mobileChange, deletion: deletion}
+}
+
+func (h *AuthHandler) LoginByMobile(ctx context.Context, req *authv1.LoginByMobileRequest) (*authv1.LoginByMobileResponse, error) {
+	if err := h.auth.LoginByMobile(ctx, req.GetMobile(), req.GetCaptchaToken(), metaFromContext(ctx)); err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &authv1.LoginByMobileResponse{}, nil
+}
+
+func (h *AuthHandler) VerifyOTP(ctx context.Context, req *authv1.VerifyOTPRequest) (*authv1.VerifyOTPResponse, error) {
+	pair, err := h.auth.VerifyOTP(ctx, req.GetMobile(), req.GetCode(), req.GetClientId(), metaFromContext(ctx))
+	if err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &authv1.VerifyOTPResponse{
+		AccessToken:      pair.AccessToken,
+		AccessExpiresAt:  timestamppb.New(pair.AccessExpiresAt),
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresAt: timestamppb.New(pair.RefreshExpiresAt),
+	}, nil
+}
+
+func (h *AuthHandler) RefreshToken(ctx context.Context, req *authv1.RefreshTokenRequest) (*authv1.RefreshTokenResponse, error) {
+	pair, err := h.auth.RefreshToken(ctx, req.GetRefreshToken(), metaFromContext(ctx))
+	if err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &authv1.RefreshTokenResponse{
+		AccessToken:      pair.AccessToken,
+		AccessExpiresAt:  timestamppb.New(pair.AccessExpiresAt),
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresAt: timestamppb.New(pair.RefreshExpiresAt),
+	}, nil
+}
+
+func (h *AuthHandler) Logout(ctx context.Context, req *authv1.LogoutRequest) (*authv1.LogoutResponse, error) {
+	if err := h.auth.Logout(ctx, req.GetRefreshToken(), metaFromContext(ctx)); err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &authv1.LogoutResponse{}, nil
+}
+
+func (h *AuthHandler) ListMyLoginActivity(ctx context.Context, req *authv1.ListMyLoginActivityRequest) (*authv1.ListLoginAuditResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing claims")
+	}
+	events, total, err := h.audit.QueryOwn(ctx, c.UserID, domain.AuditPage{
+		Limit:  int(req.GetPageSize()),
+		Offset: int(req.GetPage()) * int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, mapAuthError(err)
+	}
+	return auditResponse(events, total), nil
+}
+
+func mapAuthError(err error) error {
+	// Credential failures share one message on purpose: the response must
+	// not reveal whether a mobile, code, or session exists.
+	switch {
+	case errors.Is(err, domain.ErrInvalidOTP),
+		errors.Is(err, domain.ErrOTPExpired),
+		errors.Is(err, domain.ErrUserNotFound),
+		errors.Is(err, domain.ErrSessionNotFound),
+		errors.Is(err, domain.ErrSessionRevoked),
+		errors.Is(err, domain.ErrSessionExpired):
+		return status.Error(codes.Unauthenticated, "invalid credentials")
+	case errors.Is(err, domain.ErrTooManyAttempts):
+		return status.Error(codes.ResourceExhausted, "too many attempts, try again later")
+	case errors.Is(err, domain.ErrAccountDisabled):
+		return status.Error(codes.PermissionDenied, "account disabled")
+	case errors.Is(err, domain.ErrInvalidStatusTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrCaptchaRequired):
+		return status.Error(codes.FailedPrecondition, "captcha required")
+	case errors.Is(err, domain.ErrCaptchaInvalid):
+		return status.Error(codes.InvalidArgument, "captcha invalid")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func auditResponse(events []*domain.AuditEvent, total int64) *authv1.ListLoginAuditResponse {
+	resp := &authv1.ListLoginAuditResponse{Total: total}
+	for _, e := range events {
+		pb := &authv1.LoginAuditEvent{
+			Id:        e.ID.String(),
+			Mobile:    e.Mobile,
+			EventType: string(e.Type),
+			Ip:        e.IP,
+			UserAgent: e.UserAgent,
+			GeoHint:   e.GeoHint,
+			Metadata:  e.Metadata,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		}
+		if e.UserID != nil {
+			pb.UserId = e.UserID.String()
+		}
+		resp.Events = append(resp.Events, pb)
+	}
+	return resp
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	authv1 "github.com/K-H-Tech/infera/proto/gen/go/auth/v1"
+	"github.com/K-H-Tech/infera/services/auth/domain"
+	"github.com/K-H-Tech/infera/services/auth/service"
+)
+
+// RoleBackoffice marks backoffice operators in forwarded claims.
+const RoleBackoffice = "backoffice"
+
+// BackofficeHandler serves the internal support/operations API.
+type BackofficeHandler struct {
+	authv1.UnimplementedAuthBackofficeServiceServer
+
+	audit         *service.AuditService
+	impersonation *service.ImpersonationService
+	sessionAdmin  *service.SessionAdminService
+}
+
+// NewBackofficeHandler builds the backoffice auth handler.
+func NewBackofficeHandler(audit *service.AuditService, impersonation *service.ImpersonationService, sessionAdmin *service.SessionAdminService) *BackofficeHandler {
+	return &BackofficeHandler{audit: audit, impersonation: impersonation, sessionAdmin: sessionAdmin}
+}
+
+func (h *BackofficeHandler) ForceSessionExpiry(ctx context.Context, req *authv1.ForceSessionExpiryRequest) (*authv1.ForceSessionExpiryResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok || !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.PermissionDenied, "backoffice role required")
+	}
+	hasUser := req.GetUserId() != ""
+	hasCutoff := req.GetIssuedBefore() != nil
+	if hasUser == hasCutoff {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of user_id or issued_before must be set")
+	}
+
+	var (
+		count int
+		err   error
+	)
+	if hasUser {
+		userID, parseErr := uuid.Parse(req.GetUserId())
+		if parseErr != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+		}
+		count, err = h.sessionAdmin.ExpireForUser(ctx, userID, c.UserID, req.GetReason())
+	} else {
+		count, err = h.sessionAdmin.ExpireIssuedBefore(ctx, req.GetIssuedBefore().AsTime(), c.UserID, req.GetReason())
+	}
+	if err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &authv1.ForceSessionExpiryResponse{ExpiredCount: int64(count)}, nil
+}
+
+func (h *BackofficeHandler) Impersonate(ctx context.Context, req *authv1.ImpersonateRequest) (*authv1.ImpersonateResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok || !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.PermissionDenied, "backoffice role required")
+	}
+	if c.Impersonated() {
+		return nil, status.Error(codes.PermissionDenied, "impersonation cannot be chained")
+	}
+	targetID, err := uuid.Parse(req.GetTargetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target_user_id")
+	}
+	token, expiresAt, err := h.impersonation.Impersonate(ctx, c.UserID, targetID,
+		req.GetReason(), time.Duration(req.GetTtlSeconds())*time.Second)
+	if err != nil {
+		if errors.Is(err, service.ErrReasonRequired) {
+			return nil, status.Error(codes.InvalidArgument, "reason is required")
+		}
+		return nil, mapAuthError(err)
+	}
+	return &authv1.ImpersonateResponse{
+		AccessToken: token,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}, nil
+}
+
+func (h *BackofficeHandler) ListLoginAudit(ctx context.Context, req *authv1.ListLoginAuditRequest) (*authv1.ListLoginAuditResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok || !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.PermissionDenied, "backoffice role required")
+	}
+
+	filter := domain.AuditFilter{Mobile: req.GetMobile()}
+	if req.GetUserId() != "" {
+		id, err := uuid.Parse(req.GetUserId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+		}
+		filter.UserID = &id
+	}
+	for _, t := range req.GetEventTypes() {
+		filter.Types = append(filter.Types, domain.AuditEventType(t))
+	}
+	if req.GetFrom() != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+
+	events, total, err := h.audit.Query(ctx, filter, domain.AuditPage{
+		Limit:  int(req.GetPageSize()),
+		Offset: int(req.GetPage()) * int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, mapAuthError(err)
+	}
+	return auditResponse(events, total), nil
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	authv1 "github.com/K-H-Tech/infera/proto/gen/go/auth/v1"
+)
+
+func (h *AuthHandler) RequestAccountDeletion(ctx context.Context, _ *authv1.RequestAccountDeletionRequest) (*authv1.RequestAccountDeletionResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing claims")
+	}
+	if err := h.deletion.Request(ctx, c.UserID); err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &authv1.RequestAccountDeletionResponse{}, nil
+}
+
+func (h *AuthHandler) ConfirmAccountDeletion(ctx context.Context, req *authv1.ConfirmAccountDeletionRequest) (*authv1.ConfirmAccountDeletionResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing claims")
+	}
+	if err := h.deletion.Confirm(ctx, c.UserID, req.GetCode()); err != nil {
+		return nil, mapAuthError(err)
+	}
+	return &authv1.ConfirmAccountDeletionResponse{}, nil
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/K-H-Tech/infera/services/auth/service"
+)
+
+// Metadata keys set by the gateway when it proxies a request inward.
+const (
+	mdClientIP  = "x-client-ip"
+	mdUserAgent = "x-client-user-agent"
+	mdGeoHint   = "x-geo-hint"
+)
+
+// metaFromContext pulls the transport facts the gateway forwarded for this
+// request. Missing keys simply yield empty fields.
+func metaFromContext(ctx context.Context) service.RequestMeta {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return service.RequestMeta{}
+	}
+	first := func(key string) string {
+		if vals := md.Get(key); len(vals) > 0 {
+			return vals[0]
+		}
+		return ""
+	}
+	return service.RequestMeta{
+		IP:        first(mdClientIP),
+		UserAgent: first(mdUserAgent),
+		GeoHint:   first(mdGeoHint),
+	}
+}
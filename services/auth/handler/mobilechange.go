@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	authv1 "github.com/K-H-Tech/infera/proto/gen/go/auth/v1"
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+func (h *AuthHandler) StartMobileChange(ctx context.Context, req *authv1.StartMobileChangeRequest) (*authv1.StartMobileChangeResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing claims")
+	}
+	change, err := h.mobileChange.Start(ctx, c.UserID, req.GetNewMobile())
+	if err != nil {
+		return nil, mapMobileChangeError(err)
+	}
+	return &authv1.StartMobileChangeResponse{
+		ChangeId:  change.ID.String(),
+		ExpiresAt: timestamppb.New(change.ExpiresAt),
+	}, nil
+}
+
+func (h *AuthHandler) ConfirmMobileChange(ctx context.Context, req *authv1.ConfirmMobileChangeRequest) (*authv1.ConfirmMobileChangeResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing claims")
+	}
+	changeID, err := uuid.Parse(req.GetChangeId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid change_id")
+	}
+	change, err := h.mobileChange.Confirm(ctx, c.UserID, changeID, req.GetTarget(), req.GetCode())
+	if err != nil {
+		return nil, mapMobileChangeError(err)
+	}
+	resp := &authv1.ConfirmMobileChangeResponse{Status: string(change.Status)}
+	if change.EffectiveAt != nil {
+		resp.EffectiveAt = timestamppb.New(*change.EffectiveAt)
+	}
+	return resp, nil
+}
+
+func (h *AuthHandler) CancelMobileChange(ctx context.Context, req *authv1.CancelMobileChangeRequest) (*authv1.CancelMobileChangeResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing claims")
+	}
+	changeID, err := uuid.Parse(req.GetChangeId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid change_id")
+	}
+	if err := h.mobileChange.Cancel(ctx, c.UserID, changeID); err != nil {
+		return nil, mapMobileChangeError(err)
+	}
+	return &authv1.CancelMobileChangeResponse{}, nil
+}
+
+func mapMobileChangeError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrMobileChangeNotFound):
+		return status.Error(codes.NotFound, "mobile change request not found")
+	case errors.Is(err, domain.ErrMobileChangeState):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrMobileInUse):
+		return status.Error(codes.AlreadyExists, "mobile already in use")
+	default:
+		return mapAuthError(err)
+	}
+}
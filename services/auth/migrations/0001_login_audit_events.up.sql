@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS login_audit_events (
+    id          UUID PRIMARY KEY,
+    user_id     UUID,
+    mobile      TEXT NOT NULL DEFAULT '',
+    event_type  TEXT NOT NULL,
+    ip          TEXT NOT NULL DEFAULT '',
+    user_agent  TEXT NOT NULL DEFAULT '',
+    geo_hint    TEXT NOT NULL DEFAULT '',
+    metadata    JSONB,
+    created_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_audit_events_user_created
+    ON login_audit_events (user_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_login_audit_events_mobile_created
+    ON login_audit_events (mobile, created_at DESC);
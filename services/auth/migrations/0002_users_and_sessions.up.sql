@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS auth_users (
+    id          UUID PRIMARY KEY,
+    mobile      TEXT NOT NULL UNIQUE,
+    status      TEXT NOT NULL DEFAULT 'pending',
+    created_at  TIMESTAMPTZ NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS auth_sessions (
+    id                  UUID PRIMARY KEY,
+    user_id             UUID NOT NULL REFERENCES auth_users (id),
+    refresh_token_hash  TEXT NOT NULL UNIQUE,
+    client_id           TEXT NOT NULL DEFAULT '',
+    ip                  TEXT NOT NULL DEFAULT '',
+    user_agent          TEXT NOT NULL DEFAULT '',
+    issued_at           TIMESTAMPTZ NOT NULL,
+    expires_at          TIMESTAMPTZ NOT NULL,
+    revoked_at          TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_user ON auth_sessions (user_id);
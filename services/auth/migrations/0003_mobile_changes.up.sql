@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS mobile_changes (
+    id                UUID PRIMARY KEY,
+    user_id           UUID NOT NULL REFERENCES auth_users (id),
+    old_mobile        TEXT NOT NULL,
+    new_mobile        TEXT NOT NULL,
+    status            TEXT NOT NULL DEFAULT 'pending',
+    old_confirmed_at  TIMESTAMPTZ,
+    new_confirmed_at  TIMESTAMPTZ,
+    effective_at      TIMESTAMPTZ,
+    created_at        TIMESTAMPTZ NOT NULL,
+    expires_at        TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_mobile_changes_user ON mobile_changes (user_id);
+CREATE INDEX IF NOT EXISTS idx_mobile_changes_due
+    ON mobile_changes (effective_at) WHERE status = 'confirmed';
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS account_tombstones (
+    user_id      UUID PRIMARY KEY,
+    mobile_hash  TEXT NOT NULL,
+    reason       TEXT NOT NULL DEFAULT '',
+    deleted_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_account_tombstones_mobile_hash
+    ON account_tombstones (mobile_hash);
@@ -0,0 +1,10 @@
+-- Track propagation of applied mobile changes to the user service, so
+-- a failed RPC is retried instead of leaving the profile desynced.
+ALTER TABLE mobile_changes ADD COLUMN IF NOT EXISTS propagated_at TIMESTAMPTZ;
+
+-- Applied rows already propagated before this column existed must not
+-- be replayed against the user service.
+UPDATE mobile_changes SET propagated_at = effective_at WHERE status = 'applied';
+
+CREATE INDEX IF NOT EXISTS idx_mobile_changes_unpropagated
+    ON mobile_changes (effective_at) WHERE status = 'applied' AND propagated_at IS NULL;
@@ -0,0 +1,8 @@
+// Package migrations embeds the auth service schema migrations for
+// pkg/db/migrate, which applies them at startup.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// AuditRepository is the Postgres implementation of domain.AuditRepository.
+type AuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuditRepository builds an audit repository over the given pool.
+func NewAuditRepository(pool *pgxpool.Pool) *AuditRepository {
+	return &AuditRepository{pool: pool}
+}
+
+// Insert appends one event to the audit trail.
+func (r *AuditRepository) Insert(ctx context.Context, event *domain.AuditEvent) error {
+	const q = `
+		INSERT INTO login_audit_events
+			(id, user_id, mobile, event_type, ip, user_agent, geo_hint, metadata, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := r.pool.Exec(ctx, q,
+		event.ID, event.UserID, event.Mobile, string(event.Type),
+		event.IP, event.UserAgent, event.GeoHint, event.Metadata, event.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert login_audit_events: %w", err)
+	}
+	return nil
+}
+
+// List returns a page of matching events, newest first, plus the total count.
+func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter, page domain.AuditPage) ([]*domain.AuditEvent, int64, error) {
+	where, args := buildAuditWhere(filter)
+
+	var total int64
+	countQ := "SELECT count(*) FROM login_audit_events" + where
+	if err := r.pool.QueryRow(ctx, countQ, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count login_audit_events: %w", err)
+	}
+
+	listQ := fmt.Sprintf(`
+		SELECT id, user_id, mobile, event_type, ip, user_agent, geo_hint, metadata, created_at
+		FROM login_audit_events%s
+		ORDER BY created_at DESC
+		LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+	rows, err := r.pool.Query(ctx, listQ, append(args, page.Limit, page.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list login_audit_events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*domain.AuditEvent
+	for rows.Next() {
+		var (
+			e   domain.AuditEvent
+			typ string
+		)
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Mobile, &typ, &e.IP, &e.UserAgent, &e.GeoHint, &e.Metadata, &e.CreatedAt); err != nil {
+			return nil, 0, fmt.Errorf("scan login_audit_events: %w", err)
+		}
+		e.Type = domain.AuditEventType(typ)
+		events = append(events, &e)
+	}
+	return events, total, rows.Err()
+}
+
+func buildAuditWhere(filter domain.AuditFilter) (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if filter.UserID != nil {
+		add("user_id = $%d", *filter.UserID)
+	}
+	if filter.Mobile != "" {
+		add("mobile = $%d", filter.Mobile)
+	}
+	if len(filter.Types) > 0 {
+		types := make([]string, len(filter.Types))
+		for i, t := range filter.Types {
+			types[i] = string(t)
+		}
+		add("event_type = ANY($%d)", types)
+	}
+	if !filter.From.IsZero() {
+		add("created_at >= $%d", filter.From)
+	}
+	if !filter.To.IsZero() {
+		add("created_at < $%d", filter.To)
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// MobileChangeRepository is the Postgres implementation of
+// domain.MobileChangeRepository.
+type MobileChangeRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMobileChangeRepository builds the repository over the given pool.
+func NewMobileChangeRepository(pool *pgxpool.Pool) *MobileChangeRepository {
+	return &MobileChangeRepository{pool: pool}
+}
+
+const mobileChangeColumns = "id, user_id, old_mobile, new_mobile, status, old_confirmed_at, new_confirmed_at, effective_at, propagated_at, created_at, expires_at"
+
+func (r *MobileChangeRepository) Create(ctx context.Context, change *domain.MobileChange) error {
+	const q = `
+		INSERT INTO mobile_changes
+			(id, user_id, old_mobile, new_mobile, status, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := r.pool.Exec(ctx, q,
+		change.ID, change.UserID, change.OldMobile, change.NewMobile,
+		string(change.Status), change.CreatedAt, change.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert mobile_changes: %w", err)
+	}
+	return nil
+}
+
+func (r *MobileChangeRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.MobileChange, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+mobileChangeColumns+" FROM mobile_changes WHERE id = $1", id)
+	return scanMobileChange(row)
+}
+
+func (r *MobileChangeRepository) GetPendingByUser(ctx context.Context, userID uuid.UUID) (*domain.MobileChange, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+mobileChangeColumns+` FROM mobile_changes
+		 WHERE user_id = $1 AND status IN ('pending', 'confirmed') AND expires_at > now()
+		 ORDER BY created_at DESC LIMIT 1`, userID)
+	return scanMobileChange(row)
+}
+
+func (r *MobileChangeRepository) Update(ctx context.Context, change *domain.MobileChange) error {
+	const q = `
+		UPDATE mobile_changes
+		SET status = $2, old_confirmed_at = $3, new_confirmed_at = $4, effective_at = $5, propagated_at = $6
+		WHERE id = $1`
+	tag, err := r.pool.Exec(ctx, q, change.ID, string(change.Status),
+		change.OldConfirmedAt, change.NewConfirmedAt, change.EffectiveAt, change.PropagatedAt)
+	if err != nil {
+		return fmt.Errorf("update mobile_changes: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMobileChangeNotFound
+	}
+	return nil
+}
+
+func (r *MobileChangeRepository) ListApplicable(ctx context.Context, now time.Time, limit int) ([]*domain.MobileChange, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+mobileChangeColumns+` FROM mobile_changes
+		 WHERE status = 'confirmed' AND effective_at <= $1
+		 ORDER BY effective_at LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list applicable mobile_changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*domain.MobileChange
+	for rows.Next() {
+		c, err := scanMobileChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+func (r *MobileChangeRepository) ListUnpropagated(ctx context.Context, limit int) ([]*domain.MobileChange, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+mobileChangeColumns+` FROM mobile_changes
+		 WHERE status = 'applied' AND propagated_at IS NULL
+		 ORDER BY effective_at LIMIT $1`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list unpropagated mobile_changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*domain.MobileChange
+	for rows.Next() {
+		c, err := scanMobileChange(rows)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+func scanMobileChange(row pgx.Row) (*domain.MobileChange, error) {
+	var (
+		c      domain.MobileChange
+		status string
+	)
+	err := row.Scan(&c.ID, &c.UserID, &c.OldMobile, &c.NewMobile, &status,
+		&c.OldConfirmedAt, &c.NewConfirmedAt, &c.EffectiveAt, &c.PropagatedAt, &c.CreatedAt, &c.ExpiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrMobileChangeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select mobile_changes: %w", err)
+	}
+	c.Status = domain.MobileChangeStatus(status)
+	return &c, nil
+}
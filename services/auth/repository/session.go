@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// SessionRepository is the Postgres implementation of
+// domain.SessionRepository.
+type SessionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSessionRepository builds a session repository over the given pool.
+func NewSessionRepository(pool *pgxpool.Pool) *SessionRepository {
+	return &SessionRepository{pool: pool}
+}
+
+const sessionColumns = "id, user_id, refresh_token_hash, client_id, ip, user_agent, issued_at, expires_at, revoked_at"
+
+func (r *SessionRepository) Create(ctx context.Context, session *domain.Session) error {
+	const q = `
+		INSERT INTO auth_sessions
+			(id, user_id, refresh_token_hash, client_id, ip, user_agent, issued_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err := r.pool.Exec(ctx, q,
+		session.ID, session.UserID, session.RefreshTokenHash, session.ClientID,
+		session.IP, session.UserAgent, session.IssuedAt, session.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("insert auth_sessions: %w", err)
+	}
+	return nil
+}
+
+func (r *SessionRepository) GetByTokenHash(ctx context.Context, hash string) (*domain.Session, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+sessionColumns+" FROM auth_sessions WHERE refresh_token_hash = $1", hash)
+	return scanSession(row)
+}
+
+func (r *SessionRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Session, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+sessionColumns+" FROM auth_sessions WHERE user_id = $1 ORDER BY issued_at DESC", userID)
+	if err != nil {
+		return nil, fmt.Errorf("list auth_sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*domain.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+func (r *SessionRepository) ListActiveIssuedBefore(ctx context.Context, before time.Time, limit int) ([]*domain.Session, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+sessionColumns+` FROM auth_sessions
+		 WHERE issued_at < $1 AND revoked_at IS NULL AND expires_at > now()
+		 ORDER BY issued_at LIMIT $2`, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list active auth_sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*domain.Session
+	for rows.Next() {
+		s, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	return sessions, rows.Err()
+}
+
+func (r *SessionRepository) Revoke(ctx context.Context, id uuid.UUID, at time.Time) error {
+	tag, err := r.pool.Exec(ctx,
+		"UPDATE auth_sessions SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL", id, at)
+	if err != nil {
+		return fmt.Errorf("revoke auth_sessions: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrSessionNotFound
+	}
+	return nil
+}
+
+func (r *SessionRepository) RevokeAllForUser(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	_, err := r.pool.Exec(ctx,
+		"UPDATE auth_sessions SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL", userID, at)
+	if err != nil {
+		return fmt.Errorf("revoke user auth_sessions: %w", err)
+	}
+	return nil
+}
+
+func scanSession(row pgx.Row) (*domain.Session, error) {
+	var s domain.Session
+	err := row.Scan(&s.ID, &s.UserID, &s.RefreshTokenHash, &s.ClientID,
+		&s.IP, &s.UserAgent, &s.IssuedAt, &s.ExpiresAt, &s.RevokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select auth_sessions: %w", err)
+	}
+	return &s, nil
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// TombstoneRepository is the Postgres implementation of
+// domain.TombstoneRepository.
+type TombstoneRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTombstoneRepository builds the repository over the given pool.
+func NewTombstoneRepository(pool *pgxpool.Pool) *TombstoneRepository {
+	return &TombstoneRepository{pool: pool}
+}
+
+func (r *TombstoneRepository) Create(ctx context.Context, t *domain.AccountTombstone) error {
+	const q = `
+		INSERT INTO account_tombstones (user_id, mobile_hash, reason, deleted_at)
+		VALUES ($1, $2, $3, $4)`
+	_, err := r.pool.Exec(ctx, q, t.UserID, t.MobileHash, t.Reason, t.DeletedAt)
+	if err != nil {
+		return fmt.Errorf("insert account_tombstones: %w", err)
+	}
+	return nil
+}
+
+func (r *TombstoneRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.AccountTombstone, error) {
+	var t domain.AccountTombstone
+	err := r.pool.QueryRow(ctx,
+		"SELECT user_id, mobile_hash, reason, deleted_at FROM account_tombstones WHERE user_id = $1", userID).
+		Scan(&t.UserID, &t.MobileHash, &t.Reason, &t.DeletedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrTombstoneNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select account_tombstones: %w", err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// UserRepository is the Postgres implementation of domain.UserRepository.
+type UserRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewUserRepository builds a user repository over the given pool.
+func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
+	return &UserRepository{pool: pool}
+}
+
+const userColumns = "id, mobile, status, created_at, updated_at"
+
+func (r *UserRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.User, error) {
+	return r.get(ctx, "SELECT "+userColumns+" FROM auth_users WHERE id = $1", id)
+}
+
+func (r *UserRepository) GetByMobile(ctx context.Context, mobile string) (*domain.User, error) {
+	return r.get(ctx, "SELECT "+userColumns+" FROM auth_users WHERE mobile = $1", mobile)
+}
+
+func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	const q = `
+		INSERT INTO auth_users (id, mobile, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)`
+	_, err := r.pool.Exec(ctx, q, user.ID, user.Mobile, string(user.Status), user.CreatedAt, user.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("insert auth_users: %w", err)
+	}
+	return nil
+}
+
+func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	const q = `
+		UPDATE auth_users
+		SET mobile = $2, status = $3, updated_at = $4
+		WHERE id = $1`
+	tag, err := r.pool.Exec(ctx, q, user.ID, user.Mobile, string(user.Status), user.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("update auth_users: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepository) get(ctx context.Context, query string, arg any) (*domain.User, error) {
+	var (
+		u      domain.User
+		status string
+	)
+	err := r.pool.QueryRow(ctx, query, arg).
+		Scan(&u.ID, &u.Mobile, &status, &u.CreatedAt, &u.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select auth_users: %w", err)
+	}
+	u.Status = domain.AccountStatus(status)
+	return &u, nil
+}
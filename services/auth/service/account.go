@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// AccountService manages account lifecycle transitions. Token issuance and
+// refresh consult the resulting status, so a block takes effect on the next
+// token operation at the latest; revocation handles the window in between.
+type AccountService struct {
+	users    domain.UserRepository
+	sessions domain.SessionRepository
+	audit    *AuditService
+	events   domain.EventPublisher
+	log      *slog.Logger
+	now      func() time.Time
+}
+
+// NewAccountService builds the account lifecycle service.
+func NewAccountService(users domain.UserRepository, sessions domain.SessionRepository, audit *AuditService, events domain.EventPublisher, log *slog.Logger) *AccountService {
+	return &AccountService{users: users, sessions: sessions, audit: audit, events: events, log: log, now: time.Now}
+}
+
+// SetStatus transitions the user to the target status, enforcing the state
+// machine. Moving into suspended, blocked, or deleted also revokes every
+// session so existing refresh tokens stop working immediately.
+func (s *AccountService) SetStatus(ctx context.Context, userID uuid.UUID, target domain.AccountStatus, reason string) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	previous := user.Status
+	if err := user.TransitionTo(target); err != nil {
+		return err
+	}
+	user.UpdatedAt = s.now()
+	if err := s.users.Update(ctx, user); err != nil {
+		return fmt.Errorf("update user status: %w", err)
+	}
+
+	if !target.CanAuthenticate() {
+		if err := s.sessions.RevokeAllForUser(ctx, userID, s.now()); err != nil {
+			// The status is already persisted; the account cannot mint
+			// new tokens. Log loudly so the leaked sessions get chased.
+			s.log.Error("account: revoking sessions after status change failed",
+				"user_id", userID, "status", string(target), "error", err)
+		}
+	}
+
+	if target == domain.StatusBlocked && s.events != nil {
+		if err := s.events.Publish(ctx, domain.Event{
+			Name:       domain.EventAccountBlocked,
+			UserID:     userID,
+			OccurredAt: s.now(),
+			Payload:    map[string]string{"reason": reason},
+		}); err != nil {
+			s.log.Error("account: publishing event failed", "event", domain.EventAccountBlocked, "error", err)
+		}
+	}
+
+	s.audit.Record(ctx, &domain.AuditEvent{
+		UserID: &userID,
+		Mobile: user.Mobile,
+		Type:   domain.AuditLockout,
+		Metadata: map[string]string{
+			"from":   string(previous),
+			"to":     string(target),
+			"reason": reason,
+		},
+	})
+	return nil
+}
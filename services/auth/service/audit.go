@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+const (
+	auditDefaultPageSize = 20
+	auditMaxPageSize     = 100
+)
+
+// AuditService records authentication events and serves the query API used
+// by the backoffice and by the user's own "recent activity" view.
+type AuditService struct {
+	repo domain.AuditRepository
+	log  *slog.Logger
+	now  func() time.Time
+}
+
+// NewAuditService builds an AuditService on top of the given repository.
+func NewAuditService(repo domain.AuditRepository, log *slog.Logger) *AuditService {
+	return &AuditService{repo: repo, log: log, now: time.Now}
+}
+
+// Record persists one audit event. Auditing must never fail the login path,
+// so persistence errors are logged and swallowed.
+func (s *AuditService) Record(ctx context.Context, event *domain.AuditEvent) {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = s.now()
+	}
+	if err := s.repo.Insert(ctx, event); err != nil {
+		s.log.Error("audit: insert failed",
+			"type", string(event.Type),
+			"mobile", event.Mobile,
+			"error", err,
+		)
+	}
+}
+
+// Query returns a page of audit events matching the filter, newest first,
+// together with the total number of matching rows.
+func (s *AuditService) Query(ctx context.Context, filter domain.AuditFilter, page domain.AuditPage) ([]*domain.AuditEvent, int64, error) {
+	if page.Limit <= 0 {
+		page.Limit = auditDefaultPageSize
+	}
+	if page.Limit > auditMaxPageSize {
+		page.Limit = auditMaxPageSize
+	}
+	if page.Offset < 0 {
+		page.Offset = 0
+	}
+	return s.repo.List(ctx, filter, page)
+}
+
+// QueryOwn is the self-service variant: it forces the filter onto the
+// authenticated user so a caller can never read someone else's trail.
+func (s *AuditService) QueryOwn(ctx context.Context, userID uuid.UUID, page domain.AuditPage) ([]*domain.AuditEvent, int64, error) {
+	return s.Query(ctx, domain.AuditFilter{UserID: &userID}, page)
+}
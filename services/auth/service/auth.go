@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// TokenIssuer mints access tokens for an authenticated user. accessTTL is
+// resolved per client type by the service layer.
+type TokenIssuer interface {
+	Issue(ctx context.Context, user *domain.User, session *domain.Session, accessTTL time.Duration) (token string, expiresAt time.Time, err error)
+}
+
+// NotificationClient is the slice of the notification service auth depends
+// on: delivering OTPs and security notices to the user's handset.
+type NotificationClient interface {
+	SendOTP(ctx context.Context, mobile, code string) error
+	// SendMobileChanged notifies a number that the account it belongs (or
+	// belonged) to now uses newMobile.
+	SendMobileChanged(ctx context.Context, mobile, newMobile string) error
+}
+
+// RequestMeta carries transport-level facts (resolved by the gateway) that
+// the domain wants for auditing but must not trust for authorization.
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+	GeoHint   string
+}
+
+// TokenPair is the result of a successful authentication.
+type TokenPair struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// AuthService implements the OTP login flow.
+type AuthService struct {
+	users    domain.UserRepository
+	sessions domain.SessionRepository
+	otp      domain.OTPStore
+	issuer   TokenIssuer
+	notifier NotificationClient
+	audit    *AuditService
+	throttle *LoginThrottle
+	captcha  *CaptchaPolicy
+	events   domain.EventPublisher
+	log      *slog.Logger
+
+	otpTTL    time.Duration
+	lifetimes ClientLifetimes
+	now       func() time.Time
+}
+
+// AuthServiceParams collects the dependencies of AuthService.
+type AuthServiceParams struct {
+	Users    domain.UserRepository
+	Sessions domain.SessionRepository
+	OTP      domain.OTPStore
+	Issuer   TokenIssuer
+	Notifier NotificationClient
+	Audit    *AuditService
+	Throttle *LoginThrottle
+	Captcha  *CaptchaPolicy
+	Events   domain.EventPublisher
+	Log      *slog.Logger
+	OTPTTL   time.Duration
+	// Lifetimes holds per-client token TTLs; nil uses the defaults.
+	Lifetimes ClientLifetimes
+}
+
+// NewAuthService wires the OTP login flow together.
+func NewAuthService(p AuthServiceParams) *AuthService {
+	if p.Lifetimes == nil {
+		p.Lifetimes = DefaultClientLifetimes()
+	}
+	return &AuthService{
+		users:     p.Users,
+		sessions:  p.Sessions,
+		otp:       p.OTP,
+		issuer:    p.Issuer,
+		notifier:  p.Notifier,
+		audit:     p.Audit,
+		throttle:  p.Throttle,
+		captcha:   p.Captcha,
+		events:    p.Events,
+		log:       p.Log,
+		otpTTL:    p.OTPTTL,
+		lifetimes: p.Lifetimes,
+		now:       time.Now,
+	}
+}
+
+// LoginByMobile generates an OTP for the mobile and hands it to the
+// notification service. The user row is created lazily on first login, and
+// the response is identical whether or not the mobile is known, so the
+// endpoint cannot be used to enumerate accounts.
+func (s *AuthService) LoginByMobile(ctx context.Context, mobile, captchaToken string, meta RequestMeta) error {
+	if err := s.throttle.AllowLogin(ctx, mobile, meta.IP); err != nil {
+		return err
+	}
+	if err := s.captcha.Check(ctx, mobile, meta.IP, captchaToken); err != nil {
+		return err
+	}
+	code, err := generateOTP(6)
+	if err != nil {
+		return fmt.Errorf("generate otp: %w", err)
+	}
+	if err := s.otp.Set(ctx, mobile, code, s.otpTTL); err != nil {
+		return fmt.Errorf("store otp: %w", err)
+	}
+	if err := s.notifier.SendOTP(ctx, mobile, code); err != nil {
+		return fmt.Errorf("send otp: %w", err)
+	}
+	s.audit.Record(ctx, &domain.AuditEvent{
+		Mobile:    mobile,
+		Type:      domain.AuditLoginRequested,
+		IP:        meta.IP,
+		UserAgent: meta.UserAgent,
+		GeoHint:   meta.GeoHint,
+	})
+	return nil
+}
+
+// VerifyOTP checks the submitted code and, on success, issues a token pair
+// and records the session.
+func (s *AuthService) VerifyOTP(ctx context.Context, mobile, code, clientID string, meta RequestMeta) (*TokenPair, error) {
+	if err := s.throttle.AllowVerify(ctx, mobile, meta.IP); err != nil {
+		return nil, err
+	}
+	stored, err := s.otp.Get(ctx, mobile)
+	if err != nil {
+		if errors.Is(err, domain.ErrOTPExpired) {
+			// Report an expired or never-requested code exactly like a
+			// wrong one; distinguishing them would confirm whether the
+			// mobile has a pending login.
+			s.recordRejected(ctx, nil, mobile, meta)
+			return nil, domain.ErrInvalidOTP
+		}
+		return nil, fmt.Errorf("load otp: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(code)) != 1 {
+		s.recordRejected(ctx, nil, mobile, meta)
+		return nil, domain.ErrInvalidOTP
+	}
+	if err := s.otp.Delete(ctx, mobile); err != nil {
+		s.log.Warn("auth: deleting consumed otp failed", "error", err)
+	}
+
+	user, err := s.users.GetByMobile(ctx, mobile)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		user = &domain.User{
+			ID:        uuid.New(),
+			Mobile:    mobile,
+			Status:    domain.StatusPending,
+			CreatedAt: s.now(),
+			UpdatedAt: s.now(),
+		}
+		if err := s.users.Create(ctx, user); err != nil {
+			return nil, fmt.Errorf("create user: %w", err)
+		}
+		s.publishEvent(ctx, domain.Event{
+			Name:       domain.EventUserRegistered,
+			UserID:     user.ID,
+			OccurredAt: s.now(),
+			Payload:    map[string]string{"mobile": mobile},
+		})
+	} else if err != nil {
+		return nil, fmt.Errorf("load user: %w", err)
+	}
+	if !user.Status.CanAuthenticate() {
+		return nil, domain.ErrAccountDisabled
+	}
+	if user.Status == domain.StatusPending {
+		if err := user.TransitionTo(domain.StatusActive); err != nil {
+			return nil, fmt.Errorf("activate user: %w", err)
+		}
+		user.UpdatedAt = s.now()
+		if err := s.users.Update(ctx, user); err != nil {
+			return nil, fmt.Errorf("activate user: %w", err)
+		}
+	}
+
+	pair, session, err := s.issueSession(ctx, user, clientID, meta)
+	if err != nil {
+		return nil, err
+	}
+	s.throttle.ResetVerify(ctx, mobile)
+	s.publishEvent(ctx, domain.Event{
+		Name:       domain.EventUserLoggedIn,
+		UserID:     user.ID,
+		OccurredAt: s.now(),
+		Payload:    map[string]string{"session_id": session.ID.String(), "ip": meta.IP},
+	})
+	s.audit.Record(ctx, &domain.AuditEvent{
+		UserID:    &user.ID,
+		Mobile:    mobile,
+		Type:      domain.AuditLoginSucceeded,
+		IP:        meta.IP,
+		UserAgent: meta.UserAgent,
+		GeoHint:   meta.GeoHint,
+		Metadata:  map[string]string{"session_id": session.ID.String()},
+	})
+	return pair, nil
+}
+
+// RefreshToken rotates a refresh token: the presented session is revoked and
+// a fresh pair is issued.
+func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string, meta RequestMeta) (*TokenPair, error) {
+	session, err := s.sessions.GetByTokenHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if session.Revoked() {
+		return nil, domain.ErrSessionRevoked
+	}
+	if s.now().After(session.ExpiresAt) {
+		return nil, domain.ErrSessionExpired
+	}
+	user, err := s.users.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("load user: %w", err)
+	}
+	if !user.Status.CanAuthenticate() {
+		return nil, domain.ErrAccountDisabled
+	}
+	if err := s.sessions.Revoke(ctx, session.ID, s.now()); err != nil {
+		return nil, fmt.Errorf("revoke session: %w", err)
+	}
+	pair, next, err := s.issueSession(ctx, user, session.ClientID, meta)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, &domain.AuditEvent{
+		UserID:    &user.ID,
+		Mobile:    user.Mobile,
+		Type:      domain.AuditTokenRefreshed,
+		IP:        meta.IP,
+		UserAgent: meta.UserAgent,
+		GeoHint:   meta.GeoHint,
+		Metadata:  map[string]string{"session_id": next.ID.String()},
+	})
+	return pair, nil
+}
+
+// Logout revokes the session behind the presented refresh token.
+func (s *AuthService) Logout(ctx context.Context, refreshToken string, meta RequestMeta) error {
+	session, err := s.sessions.GetByTokenHash(ctx, hashToken(refreshToken))
+	if err != nil {
+		return err
+	}
+	if err := s.sessions.Revoke(ctx, session.ID, s.now()); err != nil {
+		return fmt.Errorf("revoke session: %w", err)
+	}
+	s.publishEvent(ctx, domain.Event{
+		Name:       domain.EventSessionRevoked,
+		UserID:     session.UserID,
+		OccurredAt: s.now(),
+		Payload:    map[string]string{"session_id": session.ID.String(), "reason": "logout"},
+	})
+	s.audit.Record(ctx, &domain.AuditEvent{
+		UserID:    &session.UserID,
+		Type:      domain.AuditLogout,
+		IP:        meta.IP,
+		UserAgent: meta.UserAgent,
+		GeoHint:   meta.GeoHint,
+	})
+	return nil
+}
+
+func (s *AuthService) issueSession(ctx context.Context, user *domain.User, clientID string, meta RequestMeta) (*TokenPair, *domain.Session, error) {
+	refresh, err := generateToken(32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate refresh token: %w", err)
+	}
+	lifetimes := s.lifetimes.Resolve(clientID)
+	session := &domain.Session{
+		ID:               uuid.New(),
+		UserID:           user.ID,
+		RefreshTokenHash: hashToken(refresh),
+		ClientID:         clientID,
+		IP:               meta.IP,
+		UserAgent:        meta.UserAgent,
+		IssuedAt:         s.now(),
+		ExpiresAt:        s.now().Add(lifetimes.Refresh),
+	}
+	if err := s.sessions.Create(ctx, session); err != nil {
+		return nil, nil, fmt.Errorf("create session: %w", err)
+	}
+	access, expiresAt, err := s.issuer.Issue(ctx, user, session, lifetimes.Access)
+	if err != nil {
+		return nil, nil, fmt.Errorf("issue access token: %w", err)
+	}
+	return &TokenPair{
+		AccessToken:      access,
+		AccessExpiresAt:  expiresAt,
+		RefreshToken:     refresh,
+		RefreshExpiresAt: session.ExpiresAt,
+	}, session, nil
+}
+
+// publishEvent hands an event to the outbox. Event delivery is not worth
+// failing a login over, so errors are logged and swallowed.
+func (s *AuthService) publishEvent(ctx context.Context, event domain.Event) {
+	if s.events == nil {
+		return
+	}
+	if err := s.events.Publish(ctx, event); err != nil {
+		s.log.Error("auth: publishing event failed", "event", event.Name, "error", err)
+	}
+}
+
+func (s *AuthService) recordRejected(ctx context.Context, userID *uuid.UUID, mobile string, meta RequestMeta) {
+	s.audit.Record(ctx, &domain.AuditEvent{
+		UserID:    userID,
+		Mobile:    mobile,
+		Type:      domain.AuditOTPRejected,
+		IP:        meta.IP,
+		UserAgent: meta.UserAgent,
+		GeoHint:   meta.GeoHint,
+	})
+}
+
+func generateOTP(digits int) (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < digits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", digits, n), nil
+}
+
+func generateToken(bytes int) (string, error) {
+	buf := make([]byte, bytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
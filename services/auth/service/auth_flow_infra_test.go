@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/K-H-Tech/infera/services/auth/authtest"
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// These run the OTP flow against real Postgres and Redis containers, so
+// the store implementations — the Redis throttle pipeline, the session
+// repository — are covered by the same assertions as the fakes.
+
+func TestOTPFlowInfra_RequestVerifyRefreshLogout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("infra tests need Docker")
+	}
+	h := authtest.NewInfraHarness(t)
+	ctx := context.Background()
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	code := h.Notifier.LastCode(testMobile)
+	if code == "" {
+		t.Fatal("no OTP delivered")
+	}
+
+	pair, err := h.Auth.VerifyOTP(ctx, testMobile, code, "", testMeta)
+	if err != nil {
+		t.Fatalf("VerifyOTP: %v", err)
+	}
+
+	refreshed, err := h.Auth.RefreshToken(ctx, pair.RefreshToken, testMeta)
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if _, err := h.Auth.RefreshToken(ctx, pair.RefreshToken, testMeta); !errors.Is(err, domain.ErrSessionRevoked) {
+		t.Fatalf("reusing rotated refresh token: got %v, want ErrSessionRevoked", err)
+	}
+
+	if err := h.Auth.Logout(ctx, refreshed.RefreshToken, testMeta); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, err := h.Auth.RefreshToken(ctx, refreshed.RefreshToken, testMeta); !errors.Is(err, domain.ErrSessionRevoked) {
+		t.Fatalf("refresh after logout: got %v, want ErrSessionRevoked", err)
+	}
+
+	// The session rows must really be in Postgres.
+	user, err := h.Users.GetByMobile(ctx, testMobile)
+	if err != nil {
+		t.Fatalf("GetByMobile: %v", err)
+	}
+	sessions, err := h.Sessions.ListByUser(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("ListByUser: %v", err)
+	}
+	if len(sessions) == 0 {
+		t.Fatal("no sessions persisted")
+	}
+	for _, s := range sessions {
+		if s.RevokedAt == nil {
+			t.Fatalf("session %s still active after logout", s.ID)
+		}
+	}
+}
+
+func TestOTPFlowInfra_ThrottleLockout(t *testing.T) {
+	if testing.Short() {
+		t.Skip("infra tests need Docker")
+	}
+	h := authtest.NewInfraHarness(t)
+	ctx := context.Background()
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	for i := int64(0); i < h.Throttle.VerifyPerMobile; i++ {
+		if _, err := h.Auth.VerifyOTP(ctx, testMobile, "000000", "", testMeta); !errors.Is(err, domain.ErrInvalidOTP) {
+			t.Fatalf("attempt %d: got %v, want ErrInvalidOTP", i, err)
+		}
+	}
+	if _, err := h.Auth.VerifyOTP(ctx, testMobile, "000000", "", testMeta); !errors.Is(err, domain.ErrTooManyAttempts) {
+		t.Fatalf("over budget: got %v, want ErrTooManyAttempts", err)
+	}
+}
@@ -0,0 +1,157 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/K-H-Tech/infera/services/auth/authtest"
+	"github.com/K-H-Tech/infera/services/auth/domain"
+	"github.com/K-H-Tech/infera/services/auth/service"
+)
+
+const testMobile = "09120000001"
+
+var testMeta = service.RequestMeta{IP: "10.0.0.1", UserAgent: "authtest"}
+
+func TestOTPFlow_RequestVerifyRefreshLogout(t *testing.T) {
+	h := authtest.NewHarness()
+	ctx := context.Background()
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	code := h.Notifier.LastCode(testMobile)
+	if code == "" {
+		t.Fatal("no OTP delivered")
+	}
+
+	pair, err := h.Auth.VerifyOTP(ctx, testMobile, code, "", testMeta)
+	if err != nil {
+		t.Fatalf("VerifyOTP: %v", err)
+	}
+	if pair.AccessToken == "" || pair.RefreshToken == "" {
+		t.Fatal("VerifyOTP returned empty tokens")
+	}
+
+	refreshed, err := h.Auth.RefreshToken(ctx, pair.RefreshToken, testMeta)
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if refreshed.RefreshToken == pair.RefreshToken {
+		t.Fatal("refresh did not rotate the refresh token")
+	}
+
+	// The consumed refresh token must be dead.
+	if _, err := h.Auth.RefreshToken(ctx, pair.RefreshToken, testMeta); !errors.Is(err, domain.ErrSessionRevoked) {
+		t.Fatalf("reusing rotated refresh token: got %v, want ErrSessionRevoked", err)
+	}
+
+	if err := h.Auth.Logout(ctx, refreshed.RefreshToken, testMeta); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, err := h.Auth.RefreshToken(ctx, refreshed.RefreshToken, testMeta); !errors.Is(err, domain.ErrSessionRevoked) {
+		t.Fatalf("refresh after logout: got %v, want ErrSessionRevoked", err)
+	}
+}
+
+func TestVerifyOTP_WrongCode(t *testing.T) {
+	h := authtest.NewHarness()
+	ctx := context.Background()
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	if _, err := h.Auth.VerifyOTP(ctx, testMobile, "000000", "", testMeta); !errors.Is(err, domain.ErrInvalidOTP) {
+		t.Fatalf("wrong code: got %v, want ErrInvalidOTP", err)
+	}
+	// An unknown mobile must fail identically to a wrong code.
+	if _, err := h.Auth.VerifyOTP(ctx, "09129999999", "000000", "", testMeta); !errors.Is(err, domain.ErrInvalidOTP) {
+		t.Fatalf("unknown mobile: got %v, want ErrInvalidOTP", err)
+	}
+}
+
+func TestVerifyOTP_ThrottleLockout(t *testing.T) {
+	h := authtest.NewHarness()
+	ctx := context.Background()
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	for i := int64(0); i < h.Throttle.VerifyPerMobile; i++ {
+		if _, err := h.Auth.VerifyOTP(ctx, testMobile, "000000", "", testMeta); !errors.Is(err, domain.ErrInvalidOTP) {
+			t.Fatalf("attempt %d: got %v, want ErrInvalidOTP", i, err)
+		}
+	}
+	if _, err := h.Auth.VerifyOTP(ctx, testMobile, "000000", "", testMeta); !errors.Is(err, domain.ErrTooManyAttempts) {
+		t.Fatalf("over budget: got %v, want ErrTooManyAttempts", err)
+	}
+}
+
+func TestVerifyOTP_PublishesEventsAndAudit(t *testing.T) {
+	h := authtest.NewHarness()
+	ctx := context.Background()
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	if _, err := h.Auth.VerifyOTP(ctx, testMobile, h.Notifier.LastCode(testMobile), "", testMeta); err != nil {
+		t.Fatalf("VerifyOTP: %v", err)
+	}
+
+	names := h.Events.Names()
+	want := map[string]bool{domain.EventUserRegistered: false, domain.EventUserLoggedIn: false}
+	for _, n := range names {
+		if _, ok := want[n]; ok {
+			want[n] = true
+		}
+	}
+	for name, seen := range want {
+		if !seen {
+			t.Errorf("event %s not published (got %v)", name, names)
+		}
+	}
+
+	var types []domain.AuditEventType
+	for _, e := range h.AuditLog.Events() {
+		types = append(types, e.Type)
+	}
+	found := false
+	for _, typ := range types {
+		if typ == domain.AuditLoginSucceeded {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("login_succeeded audit event missing (got %v)", types)
+	}
+}
+
+func TestVerifyOTP_BlockedUserCannotAuthenticate(t *testing.T) {
+	h := authtest.NewHarness()
+	ctx := context.Background()
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	if _, err := h.Auth.VerifyOTP(ctx, testMobile, h.Notifier.LastCode(testMobile), "", testMeta); err != nil {
+		t.Fatalf("VerifyOTP: %v", err)
+	}
+	user, err := h.Users.GetByMobile(ctx, testMobile)
+	if err != nil {
+		t.Fatalf("GetByMobile: %v", err)
+	}
+	if err := user.TransitionTo(domain.StatusBlocked); err != nil {
+		t.Fatalf("TransitionTo: %v", err)
+	}
+	if err := h.Users.Update(ctx, user); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	if err := h.Auth.LoginByMobile(ctx, testMobile, "", testMeta); err != nil {
+		t.Fatalf("LoginByMobile: %v", err)
+	}
+	if _, err := h.Auth.VerifyOTP(ctx, testMobile, h.Notifier.LastCode(testMobile), "", testMeta); !errors.Is(err, domain.ErrAccountDisabled) {
+		t.Fatalf("blocked user: got %v, want ErrAccountDisabled", err)
+	}
+}
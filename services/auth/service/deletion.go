@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// deletionOTPTTL bounds how long a deletion confirmation code stays valid.
+const deletionOTPTTL = 5 * time.Minute
+
+// DeletionService runs the account deletion and data erasure flow. The
+// auth row is anonymized in place, a tombstone is kept for audit, and an
+// account.deleted event tells the user and customer services to anonymize
+// their own rows.
+type DeletionService struct {
+	users      domain.UserRepository
+	sessions   domain.SessionRepository
+	otp        domain.OTPStore
+	notifier   NotificationClient
+	tombstones domain.TombstoneRepository
+	events     domain.EventPublisher
+	audit      *AuditService
+	log        *slog.Logger
+	now        func() time.Time
+}
+
+// NewDeletionService wires the deletion flow together.
+func NewDeletionService(
+	users domain.UserRepository,
+	sessions domain.SessionRepository,
+	otp domain.OTPStore,
+	notifier NotificationClient,
+	tombstones domain.TombstoneRepository,
+	events domain.EventPublisher,
+	audit *AuditService,
+	log *slog.Logger,
+) *DeletionService {
+	return &DeletionService{
+		users:      users,
+		sessions:   sessions,
+		otp:        otp,
+		notifier:   notifier,
+		tombstones: tombstones,
+		events:     events,
+		audit:      audit,
+		log:        log,
+		now:        time.Now,
+	}
+}
+
+// Request re-verifies the user's identity by sending a confirmation code
+// to the account's mobile. Deletion is irreversible, so possession of a
+// valid access token alone is not enough.
+func (s *DeletionService) Request(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	code, err := generateOTP(6)
+	if err != nil {
+		return fmt.Errorf("generate otp: %w", err)
+	}
+	if err := s.otp.Set(ctx, deletionOTPKey(userID), code, deletionOTPTTL); err != nil {
+		return fmt.Errorf("store otp: %w", err)
+	}
+	if err := s.notifier.SendOTP(ctx, user.Mobile, code); err != nil {
+		return fmt.Errorf("send otp: %w", err)
+	}
+	return nil
+}
+
+// Confirm completes the deletion: status moves to deleted, all sessions
+// are revoked, the auth row is anonymized, a tombstone is written, and the
+// erasure event is published for the other services.
+func (s *DeletionService) Confirm(ctx context.Context, userID uuid.UUID, code string) error {
+	stored, err := s.otp.Get(ctx, deletionOTPKey(userID))
+	if err != nil {
+		if errors.Is(err, domain.ErrOTPExpired) {
+			return domain.ErrInvalidOTP
+		}
+		return fmt.Errorf("load otp: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(code)) != 1 {
+		return domain.ErrInvalidOTP
+	}
+	if err := s.otp.Delete(ctx, deletionOTPKey(userID)); err != nil {
+		s.log.Warn("deletion: deleting consumed otp failed", "error", err)
+	}
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := user.TransitionTo(domain.StatusDeleted); err != nil {
+		return err
+	}
+
+	mobile := user.Mobile
+	// Anonymize in place: the unique mobile column gets an opaque
+	// placeholder derived from the user ID so re-registration of the
+	// number is not blocked.
+	user.Mobile = "deleted:" + user.ID.String()
+	user.UpdatedAt = s.now()
+	if err := s.users.Update(ctx, user); err != nil {
+		return fmt.Errorf("anonymize user: %w", err)
+	}
+	if err := s.sessions.RevokeAllForUser(ctx, userID, s.now()); err != nil {
+		s.log.Error("deletion: revoking sessions failed", "user_id", userID, "error", err)
+	}
+	if err := s.tombstones.Create(ctx, &domain.AccountTombstone{
+		UserID:     userID,
+		MobileHash: hashToken(mobile),
+		Reason:     "user_requested",
+		DeletedAt:  s.now(),
+	}); err != nil {
+		return fmt.Errorf("create tombstone: %w", err)
+	}
+	if err := s.events.Publish(ctx, domain.Event{
+		Name:       domain.EventAccountDeleted,
+		UserID:     userID,
+		OccurredAt: s.now(),
+	}); err != nil {
+		// Publishing is the hand-off to the other services' erasure;
+		// surfacing the error lets the caller retry the confirm.
+		return fmt.Errorf("publish %s: %w", domain.EventAccountDeleted, err)
+	}
+	s.audit.Record(ctx, &domain.AuditEvent{
+		UserID:   &userID,
+		Type:     domain.AuditLogout,
+		Metadata: map[string]string{"event": "account_deleted"},
+	})
+	return nil
+}
+
+func deletionOTPKey(userID uuid.UUID) string {
+	return fmt.Sprintf("auth:deletion:%s", userID)
+}
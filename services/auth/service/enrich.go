@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// ClaimsEnricher appends claims to a token being issued. Implementations
+// typically call another service (customer_id from the customer service,
+// KYC level from the user service) so downstream authorization does not
+// need an extra lookup per request.
+type ClaimsEnricher interface {
+	// Name identifies the enricher in logs and metrics.
+	Name() string
+	// Enrich may add or overwrite entries in extra. It must not remove
+	// the reserved claims set by the issuer itself.
+	Enrich(ctx context.Context, user *domain.User, extra map[string]any) error
+}
+
+// EnrichmentPipeline runs registered enrichers during token issuance.
+type EnrichmentPipeline struct {
+	enrichers []ClaimsEnricher
+	timeout   time.Duration
+	log       *slog.Logger
+}
+
+// NewEnrichmentPipeline builds an empty pipeline. perEnricherTimeout bounds
+// each enricher's external calls so a slow dependency cannot stall logins.
+func NewEnrichmentPipeline(perEnricherTimeout time.Duration, log *slog.Logger) *EnrichmentPipeline {
+	return &EnrichmentPipeline{timeout: perEnricherTimeout, log: log}
+}
+
+// Register appends an enricher. Registration happens at wiring time and is
+// not synchronized; do not call it after the service starts.
+func (p *EnrichmentPipeline) Register(e ClaimsEnricher) {
+	p.enrichers = append(p.enrichers, e)
+}
+
+// Run executes every enricher in registration order and returns the
+// combined extra claims. Enrichment is best-effort: a failing enricher is
+// logged and skipped, because issuing a token with fewer claims beats
+// failing the login.
+func (p *EnrichmentPipeline) Run(ctx context.Context, user *domain.User) map[string]any {
+	extra := make(map[string]any)
+	if p == nil {
+		return extra
+	}
+	for _, e := range p.enrichers {
+		func() {
+			ectx := ctx
+			if p.timeout > 0 {
+				var cancel context.CancelFunc
+				ectx, cancel = context.WithTimeout(ctx, p.timeout)
+				defer cancel()
+			}
+			if err := e.Enrich(ectx, user, extra); err != nil {
+				p.log.Warn("claims enrichment failed",
+					"enricher", e.Name(), "user_id", user.ID, "error", err)
+			}
+		}()
+	}
+	return extra
+}
@@ -0,0 +1,42 @@
+package service
+
+import "time"
+
+// Known client types. Anything else falls back to the default lifetimes.
+const (
+	ClientMobileApp  = "mobile-app"
+	ClientWeb        = "web-dashboard"
+	ClientBackoffice = "backoffice"
+	ClientM2M        = "m2m"
+)
+
+// TokenLifetimes is one client type's access and refresh token TTLs.
+type TokenLifetimes struct {
+	Access  time.Duration
+	Refresh time.Duration
+}
+
+// ClientLifetimes maps a client_id to its token lifetimes. The "default"
+// entry is used for unknown or empty client IDs.
+type ClientLifetimes map[string]TokenLifetimes
+
+// DefaultClientLifetimes reflects the policy before per-client
+// configuration existed: one lifetime for everyone, with sensible
+// overrides per surface.
+func DefaultClientLifetimes() ClientLifetimes {
+	return ClientLifetimes{
+		"default":        {Access: 15 * time.Minute, Refresh: 30 * 24 * time.Hour},
+		ClientMobileApp:  {Access: 15 * time.Minute, Refresh: 90 * 24 * time.Hour},
+		ClientWeb:        {Access: 15 * time.Minute, Refresh: 7 * 24 * time.Hour},
+		ClientBackoffice: {Access: 10 * time.Minute, Refresh: 12 * time.Hour},
+		ClientM2M:        {Access: time.Hour, Refresh: 0},
+	}
+}
+
+// Resolve returns the lifetimes for clientID, falling back to "default".
+func (c ClientLifetimes) Resolve(clientID string) TokenLifetimes {
+	if lt, ok := c[clientID]; ok {
+		return lt
+	}
+	return c["default"]
+}
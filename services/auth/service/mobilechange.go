@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// UserServiceClient is the slice of the user service the auth service
+// needs: keeping the profile's mobile in sync with the auth identity.
+type UserServiceClient interface {
+	UpdateMobile(ctx context.Context, userID uuid.UUID, mobile string) error
+}
+
+// MobileChangeConfig tunes the mobile-change flow.
+type MobileChangeConfig struct {
+	// OTPTTL bounds how long the confirmation codes stay valid.
+	OTPTTL time.Duration
+	// GracePeriod is the delay between full confirmation and the change
+	// taking effect, leaving the old number a window to cancel.
+	GracePeriod time.Duration
+	// RequestTTL bounds the life of the whole request.
+	RequestTTL time.Duration
+}
+
+// DefaultMobileChangeConfig is used when the config section is absent.
+func DefaultMobileChangeConfig() MobileChangeConfig {
+	return MobileChangeConfig{
+		OTPTTL:      5 * time.Minute,
+		GracePeriod: 24 * time.Hour,
+		RequestTTL:  48 * time.Hour,
+	}
+}
+
+// MobileChangeService runs the verified mobile-change flow: OTP to both the
+// old and the new number, a grace period, then the identity update with
+// propagation to the user service.
+type MobileChangeService struct {
+	changes  domain.MobileChangeRepository
+	users    domain.UserRepository
+	sessions domain.SessionRepository
+	otp      domain.OTPStore
+	notifier NotificationClient
+	userSvc  UserServiceClient
+	audit    *AuditService
+	cfg      MobileChangeConfig
+	log      *slog.Logger
+	now      func() time.Time
+}
+
+// NewMobileChangeService wires the mobile-change flow together.
+func NewMobileChangeService(
+	changes domain.MobileChangeRepository,
+	users domain.UserRepository,
+	sessions domain.SessionRepository,
+	otp domain.OTPStore,
+	notifier NotificationClient,
+	userSvc UserServiceClient,
+	audit *AuditService,
+	cfg MobileChangeConfig,
+	log *slog.Logger,
+) *MobileChangeService {
+	return &MobileChangeService{
+		changes:  changes,
+		users:    users,
+		sessions: sessions,
+		otp:      otp,
+		notifier: notifier,
+		userSvc:  userSvc,
+		audit:    audit,
+		cfg:      cfg,
+		log:      log,
+		now:      time.Now,
+	}
+}
+
+// Start opens a change request for the authenticated user and dispatches a
+// confirmation code to both the current and the requested number.
+func (s *MobileChangeService) Start(ctx context.Context, userID uuid.UUID, newMobile string) (*domain.MobileChange, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.users.GetByMobile(ctx, newMobile); err == nil {
+		return nil, domain.ErrMobileInUse
+	} else if !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, fmt.Errorf("check new mobile: %w", err)
+	}
+	if existing, err := s.changes.GetPendingByUser(ctx, userID); err == nil && existing != nil {
+		return nil, fmt.Errorf("%w: a change is already pending", domain.ErrMobileChangeState)
+	} else if err != nil && !errors.Is(err, domain.ErrMobileChangeNotFound) {
+		return nil, err
+	}
+
+	change := &domain.MobileChange{
+		ID:        uuid.New(),
+		UserID:    userID,
+		OldMobile: user.Mobile,
+		NewMobile: newMobile,
+		Status:    domain.MobileChangePending,
+		CreatedAt: s.now(),
+		ExpiresAt: s.now().Add(s.cfg.RequestTTL),
+	}
+	if err := s.changes.Create(ctx, change); err != nil {
+		return nil, fmt.Errorf("create mobile change: %w", err)
+	}
+	for _, target := range []struct{ side, mobile string }{
+		{domain.MobileChangeTargetOld, change.OldMobile},
+		{domain.MobileChangeTargetNew, change.NewMobile},
+	} {
+		code, err := generateOTP(6)
+		if err != nil {
+			return nil, fmt.Errorf("generate otp: %w", err)
+		}
+		if err := s.otp.Set(ctx, mobileChangeOTPKey(change.ID, target.side), code, s.cfg.OTPTTL); err != nil {
+			return nil, fmt.Errorf("store otp: %w", err)
+		}
+		if err := s.notifier.SendOTP(ctx, target.mobile, code); err != nil {
+			return nil, fmt.Errorf("send otp to %s mobile: %w", target.side, err)
+		}
+	}
+	return change, nil
+}
+
+// Confirm records the OTP confirmation for one side of the change. When
+// both sides are confirmed the grace period starts.
+func (s *MobileChangeService) Confirm(ctx context.Context, userID, changeID uuid.UUID, target, code string) (*domain.MobileChange, error) {
+	if target != domain.MobileChangeTargetOld && target != domain.MobileChangeTargetNew {
+		return nil, fmt.Errorf("%w: unknown target %q", domain.ErrMobileChangeState, target)
+	}
+	change, err := s.loadOwned(ctx, userID, changeID)
+	if err != nil {
+		return nil, err
+	}
+	if change.Status != domain.MobileChangePending || s.now().After(change.ExpiresAt) {
+		return nil, domain.ErrMobileChangeState
+	}
+	stored, err := s.otp.Get(ctx, mobileChangeOTPKey(change.ID, target))
+	if err != nil {
+		if errors.Is(err, domain.ErrOTPExpired) {
+			return nil, domain.ErrInvalidOTP
+		}
+		return nil, fmt.Errorf("load otp: %w", err)
+	}
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(code)) != 1 {
+		return nil, domain.ErrInvalidOTP
+	}
+	if err := s.otp.Delete(ctx, mobileChangeOTPKey(change.ID, target)); err != nil {
+		s.log.Warn("mobile change: deleting consumed otp failed", "error", err)
+	}
+
+	at := s.now()
+	switch target {
+	case domain.MobileChangeTargetOld:
+		change.OldConfirmedAt = &at
+	case domain.MobileChangeTargetNew:
+		change.NewConfirmedAt = &at
+	}
+	if change.OldConfirmedAt != nil && change.NewConfirmedAt != nil {
+		change.Status = domain.MobileChangeConfirmed
+		effective := s.now().Add(s.cfg.GracePeriod)
+		change.EffectiveAt = &effective
+	}
+	if err := s.changes.Update(ctx, change); err != nil {
+		return nil, fmt.Errorf("update mobile change: %w", err)
+	}
+	return change, nil
+}
+
+// Cancel aborts a request that has not been applied yet. It is reachable
+// from the old number throughout the grace period.
+func (s *MobileChangeService) Cancel(ctx context.Context, userID, changeID uuid.UUID) error {
+	change, err := s.loadOwned(ctx, userID, changeID)
+	if err != nil {
+		return err
+	}
+	if change.Status == domain.MobileChangeApplied {
+		return domain.ErrMobileChangeState
+	}
+	change.Status = domain.MobileChangeCancelled
+	if err := s.changes.Update(ctx, change); err != nil {
+		return fmt.Errorf("update mobile change: %w", err)
+	}
+	return nil
+}
+
+// ApplyDue applies every confirmed request whose grace period has elapsed:
+// the auth identity moves to the new number, sessions are revoked so new
+// claims carry the new mobile, the user service is updated, and both
+// numbers are notified. It also retries propagation for applied changes
+// the user service has not acknowledged yet. Called periodically by the
+// service worker.
+func (s *MobileChangeService) ApplyDue(ctx context.Context) error {
+	// Settle propagation owed from earlier runs before taking on new
+	// work, so a recovering user service catches up oldest-first.
+	stale, err := s.changes.ListUnpropagated(ctx, 50)
+	if err != nil {
+		return fmt.Errorf("list unpropagated mobile changes: %w", err)
+	}
+	for _, change := range stale {
+		if err := s.propagate(ctx, change); err != nil {
+			s.log.Error("mobile change: propagation retry failed", "change_id", change.ID, "error", err)
+		}
+	}
+
+	due, err := s.changes.ListApplicable(ctx, s.now(), 50)
+	if err != nil {
+		return fmt.Errorf("list applicable mobile changes: %w", err)
+	}
+	for _, change := range due {
+		if err := s.apply(ctx, change); err != nil {
+			s.log.Error("mobile change: apply failed", "change_id", change.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (s *MobileChangeService) apply(ctx context.Context, change *domain.MobileChange) error {
+	user, err := s.users.GetByID(ctx, change.UserID)
+	if err != nil {
+		return err
+	}
+	user.Mobile = change.NewMobile
+	user.UpdatedAt = s.now()
+	if err := s.users.Update(ctx, user); err != nil {
+		return fmt.Errorf("update user mobile: %w", err)
+	}
+	change.Status = domain.MobileChangeApplied
+	if err := s.changes.Update(ctx, change); err != nil {
+		return fmt.Errorf("update mobile change: %w", err)
+	}
+	// Revoke sessions so every token issued from here on carries the new
+	// mobile in its claims.
+	if err := s.sessions.RevokeAllForUser(ctx, change.UserID, s.now()); err != nil {
+		s.log.Error("mobile change: revoking sessions failed", "user_id", change.UserID, "error", err)
+	}
+	// The auth identity is authoritative; a failed propagation leaves
+	// propagated_at empty and the next ApplyDue run retries it.
+	if err := s.propagate(ctx, change); err != nil {
+		s.log.Error("mobile change: user service propagation failed, will retry",
+			"user_id", change.UserID, "error", err)
+	}
+	for _, mobile := range []string{change.OldMobile, change.NewMobile} {
+		if err := s.notifier.SendMobileChanged(ctx, mobile, change.NewMobile); err != nil {
+			s.log.Warn("mobile change: notification failed", "mobile", mobile, "error", err)
+		}
+	}
+	s.audit.Record(ctx, &domain.AuditEvent{
+		UserID: &change.UserID,
+		Mobile: change.NewMobile,
+		Type:   domain.AuditMobileChanged,
+		Metadata: map[string]string{
+			"old_mobile": change.OldMobile,
+		},
+	})
+	return nil
+}
+
+// propagate pushes an applied change's new number to the user service
+// and stamps the row once it landed. UpdateMobile is idempotent on the
+// user-service side, so a retry after a lost acknowledgement is safe.
+func (s *MobileChangeService) propagate(ctx context.Context, change *domain.MobileChange) error {
+	if err := s.userSvc.UpdateMobile(ctx, change.UserID, change.NewMobile); err != nil {
+		return fmt.Errorf("propagate mobile change: %w", err)
+	}
+	at := s.now()
+	change.PropagatedAt = &at
+	if err := s.changes.Update(ctx, change); err != nil {
+		return fmt.Errorf("mark mobile change propagated: %w", err)
+	}
+	return nil
+}
+
+func (s *MobileChangeService) loadOwned(ctx context.Context, userID, changeID uuid.UUID) (*domain.MobileChange, error) {
+	change, err := s.changes.GetByID(ctx, changeID)
+	if err != nil {
+		return nil, err
+	}
+	if change.UserID != userID {
+		// Report foreign requests as missing rather than denied.
+		return nil, domain.ErrMobileChangeNotFound
+	}
+	return change, nil
+}
+
+func mobileChangeOTPKey(id uuid.UUID, target string) string {
+	return fmt.Sprintf("auth:mobile-change:%s:%s", id, target)
+}
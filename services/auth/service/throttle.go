@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// ThrottleConfig bounds authentication attempts per mobile and per IP.
+// These limits sit behind the gateway rate limiter and exist so the domain
+// stays safe even for traffic that bypasses the edge.
+type ThrottleConfig struct {
+	// LoginPerMobile caps OTP requests for one mobile per window.
+	LoginPerMobile int64
+	// LoginPerIP caps OTP requests from one IP per window.
+	LoginPerIP int64
+	// VerifyPerMobile caps verification attempts for one mobile per window.
+	VerifyPerMobile int64
+	// VerifyPerIP caps verification attempts from one IP per window.
+	VerifyPerIP int64
+	// Window is the rolling window all four counters share.
+	Window time.Duration
+}
+
+// DefaultThrottleConfig is used when the config section is absent.
+func DefaultThrottleConfig() ThrottleConfig {
+	return ThrottleConfig{
+		LoginPerMobile:  5,
+		LoginPerIP:      20,
+		VerifyPerMobile: 5,
+		VerifyPerIP:     30,
+		Window:          10 * time.Minute,
+	}
+}
+
+// LoginThrottle enforces per-mobile and per-IP attempt budgets on the OTP
+// flow and records lockouts in the audit trail.
+type LoginThrottle struct {
+	store domain.CounterStore
+	cfg   ThrottleConfig
+	audit *AuditService
+	log   *slog.Logger
+}
+
+// NewLoginThrottle builds a throttle over the given counter store.
+func NewLoginThrottle(store domain.CounterStore, cfg ThrottleConfig, audit *AuditService, log *slog.Logger) *LoginThrottle {
+	return &LoginThrottle{store: store, cfg: cfg, audit: audit, log: log}
+}
+
+// AllowLogin counts one OTP request and reports whether it is within budget.
+func (t *LoginThrottle) AllowLogin(ctx context.Context, mobile, ip string) error {
+	return t.allow(ctx, "login", mobile, ip, t.cfg.LoginPerMobile, t.cfg.LoginPerIP)
+}
+
+// AllowVerify counts one verification attempt and reports whether it is
+// within budget.
+func (t *LoginThrottle) AllowVerify(ctx context.Context, mobile, ip string) error {
+	return t.allow(ctx, "verify", mobile, ip, t.cfg.VerifyPerMobile, t.cfg.VerifyPerIP)
+}
+
+// ResetVerify clears the verification counter for a mobile after a
+// successful login so a legitimate user is not locked out by earlier typos.
+func (t *LoginThrottle) ResetVerify(ctx context.Context, mobile string) {
+	if err := t.store.Reset(ctx, counterKey("verify", "mobile", mobile)); err != nil {
+		t.log.Warn("throttle: reset failed", "error", err)
+	}
+}
+
+func (t *LoginThrottle) allow(ctx context.Context, action, mobile, ip string, perMobile, perIP int64) error {
+	exceeded := false
+	if perMobile > 0 && mobile != "" {
+		n, err := t.store.Incr(ctx, counterKey(action, "mobile", mobile), t.cfg.Window)
+		if err != nil {
+			// Fail open: losing the counter store must not take the
+			// login path down with it.
+			t.log.Error("throttle: counter store unavailable", "error", err)
+			return nil
+		}
+		exceeded = exceeded || n > perMobile
+	}
+	if perIP > 0 && ip != "" {
+		n, err := t.store.Incr(ctx, counterKey(action, "ip", ip), t.cfg.Window)
+		if err != nil {
+			t.log.Error("throttle: counter store unavailable", "error", err)
+			return nil
+		}
+		exceeded = exceeded || n > perIP
+	}
+	if exceeded {
+		t.audit.Record(ctx, &domain.AuditEvent{
+			Mobile:   mobile,
+			Type:     domain.AuditLockout,
+			IP:       ip,
+			Metadata: map[string]string{"action": action},
+		})
+		return domain.ErrTooManyAttempts
+	}
+	return nil
+}
+
+func counterKey(action, dimension, value string) string {
+	return fmt.Sprintf("auth:throttle:%s:%s:%s", action, dimension, value)
+}
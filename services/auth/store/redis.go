@@ -0,0 +1,118 @@
+// Package store contains the Redis-backed stores of the auth service:
+// pending OTPs, throttle counters, and the session revocation list.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+)
+
+// RedisOTPStore implements domain.OTPStore on Redis with native expiry.
+type RedisOTPStore struct {
+	client redis.UniversalClient
+}
+
+// NewRedisOTPStore builds the OTP store.
+func NewRedisOTPStore(client redis.UniversalClient) *RedisOTPStore {
+	return &RedisOTPStore{client: client}
+}
+
+func otpKey(mobile string) string { return "auth:otp:" + mobile }
+
+func (s *RedisOTPStore) Set(ctx context.Context, mobile, code string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, otpKey(mobile), code, ttl).Err(); err != nil {
+		return fmt.Errorf("redis set otp: %w", err)
+	}
+	return nil
+}
+
+func (s *RedisOTPStore) Get(ctx context.Context, mobile string) (string, error) {
+	code, err := s.client.Get(ctx, otpKey(mobile)).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", domain.ErrOTPExpired
+	}
+	if err != nil {
+		return "", fmt.Errorf("redis get otp: %w", err)
+	}
+	return code, nil
+}
+
+func (s *RedisOTPStore) Delete(ctx context.Context, mobile string) error {
+	if err := s.client.Del(ctx, otpKey(mobile)).Err(); err != nil {
+		return fmt.Errorf("redis del otp: %w", err)
+	}
+	return nil
+}
+
+// RedisCounterStore implements domain.CounterStore for throttling and
+// captcha velocity.
+type RedisCounterStore struct {
+	client redis.UniversalClient
+}
+
+// NewRedisCounterStore builds the counter store.
+func NewRedisCounterStore(client redis.UniversalClient) *RedisCounterStore {
+	return &RedisCounterStore{client: client}
+}
+
+func (s *RedisCounterStore) Incr(ctx context.Context, key string, window time.Duration) (int64, error) {
+	pipe := s.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	// NX keeps the original window; a counter must not live forever just
+	// because it keeps being incremented.
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("redis incr counter: %w", err)
+	}
+	return incr.Val(), nil
+}
+
+func (s *RedisCounterStore) Reset(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("redis reset counter: %w", err)
+	}
+	return nil
+}
+
+// RevocationList tracks revoked session IDs until their natural expiry, so
+// the gateway can reject access tokens whose session died early.
+type RevocationList struct {
+	client redis.UniversalClient
+}
+
+// NewRevocationList builds the revocation list.
+func NewRevocationList(client redis.UniversalClient) *RevocationList {
+	return &RevocationList{client: client}
+}
+
+func revocationKey(sessionID uuid.UUID) string {
+	return "auth:revoked:" + sessionID.String()
+}
+
+// Add marks a session revoked. ttl should cover the session's remaining
+// access-token lifetime; afterwards the token expires on its own.
+func (l *RevocationList) Add(ctx context.Context, sessionID uuid.UUID, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+	if err := l.client.Set(ctx, revocationKey(sessionID), "1", ttl).Err(); err != nil {
+		return fmt.Errorf("redis add revocation: %w", err)
+	}
+	return nil
+}
+
+// IsRevoked reports whether the session appears on the list.
+func (l *RevocationList) IsRevoked(ctx context.Context, sessionID uuid.UUID) (bool, error) {
+	n, err := l.client.Exists(ctx, revocationKey(sessionID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis check revocation: %w", err)
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,103 @@
+// Package token implements access-token issuance for the auth service.
+package token
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/auth/domain"
+	"github.com/K-H-Tech/infera/services/auth/service"
+)
+
+// Reserved claim names the enrichment pipeline must not override.
+const (
+	ClaimMobile       = "mobile"
+	ClaimSessionID    = "sid"
+	ClaimImpersonator = "act"
+	ClaimScope        = "scope"
+)
+
+// ScopeImpersonation marks tokens minted via the backoffice Impersonate
+// RPC so the gateway can restrict what they reach.
+const ScopeImpersonation = "impersonation"
+
+// JWTIssuer mints HS256 access tokens and runs the claims enrichment
+// pipeline on each issuance.
+type JWTIssuer struct {
+	secret    []byte
+	issuer    string
+	accessTTL time.Duration
+	pipeline  *service.EnrichmentPipeline
+	now       func() time.Time
+}
+
+// NewJWTIssuer builds the issuer. pipeline may be nil when no enrichers
+// are configured.
+func NewJWTIssuer(secret []byte, issuer string, accessTTL time.Duration, pipeline *service.EnrichmentPipeline) *JWTIssuer {
+	return &JWTIssuer{
+		secret:    secret,
+		issuer:    issuer,
+		accessTTL: accessTTL,
+		pipeline:  pipeline,
+		now:       time.Now,
+	}
+}
+
+// Issue implements service.TokenIssuer. accessTTL comes from the per-client
+// lifetime configuration; zero falls back to the issuer default.
+func (i *JWTIssuer) Issue(ctx context.Context, user *domain.User, session *domain.Session, accessTTL time.Duration) (string, time.Time, error) {
+	if accessTTL <= 0 {
+		accessTTL = i.accessTTL
+	}
+	expiresAt := i.now().Add(accessTTL)
+	claims := i.baseClaims(user, expiresAt)
+	claims[ClaimSessionID] = session.ID.String()
+	i.applyEnrichment(ctx, user, claims)
+	token, err := i.sign(claims)
+	return token, expiresAt, err
+}
+
+// IssueImpersonation implements service.ImpersonationTokenIssuer. The
+// token acts as the target user but carries the operator in the "act"
+// claim and an impersonation scope, so it is distinguishable everywhere.
+func (i *JWTIssuer) IssueImpersonation(ctx context.Context, target *domain.User, actorID uuid.UUID, ttl time.Duration) (string, time.Time, error) {
+	expiresAt := i.now().Add(ttl)
+	claims := i.baseClaims(target, expiresAt)
+	claims[ClaimImpersonator] = actorID.String()
+	claims[ClaimScope] = ScopeImpersonation
+	i.applyEnrichment(ctx, target, claims)
+	token, err := i.sign(claims)
+	return token, expiresAt, err
+}
+
+func (i *JWTIssuer) baseClaims(user *domain.User, expiresAt time.Time) jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":       i.issuer,
+		"sub":       user.ID.String(),
+		"iat":       i.now().Unix(),
+		"exp":       expiresAt.Unix(),
+		ClaimMobile: user.Mobile,
+	}
+}
+
+// applyEnrichment merges pipeline output, refusing to touch reserved
+// claims regardless of what an enricher returned.
+func (i *JWTIssuer) applyEnrichment(ctx context.Context, user *domain.User, claims jwt.MapClaims) {
+	if i.pipeline == nil {
+		return
+	}
+	for key, value := range i.pipeline.Run(ctx, user) {
+		switch key {
+		case "iss", "sub", "iat", "exp", ClaimMobile, ClaimSessionID, ClaimImpersonator, ClaimScope:
+			continue
+		}
+		claims[key] = value
+	}
+}
+
+func (i *JWTIssuer) sign(claims jwt.MapClaims) (string, error) {
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+}
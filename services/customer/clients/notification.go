@@ -0,0 +1,47 @@
+// Package clients holds the customer service's typed clients to sibling
+// services.
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+)
+
+// TemplateTransferOTP is the logical template for ownership-transfer
+// confirmation codes; the notification service maps it to the provider
+// template per environment.
+const TemplateTransferOTP = "business-transfer-otp"
+
+// Notification implements domain.OTPSender over the notification
+// service's gRPC API.
+type Notification struct {
+	client notificationv1.NotificationServiceClient
+	locale string
+}
+
+// NewNotification builds the client. locale is the default locale for
+// customer-originated messages.
+func NewNotification(conn grpc.ClientConnInterface, locale string) *Notification {
+	return &Notification{
+		client: notificationv1.NewNotificationServiceClient(conn),
+		locale: locale,
+	}
+}
+
+// SendTransferOTP delivers an ownership-transfer confirmation code.
+func (n *Notification) SendTransferOTP(ctx context.Context, mobile, code string) error {
+	_, err := n.client.SendOTP(ctx, &notificationv1.SendOTPRequest{
+		Mobile:   mobile,
+		Code:     code,
+		Template: TemplateTransferOTP,
+		Locale:   n.locale,
+	})
+	if err != nil {
+		return fmt.Errorf("notification SendOTP: %w", err)
+	}
+	return nil
+}
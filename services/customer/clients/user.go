@@ -0,0 +1,72 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// userStatusTTL bounds how long a user's standing is reused without
+// asking the user service again. Eligibility changes rarely, and a stale
+// positive only delays a block by minutes.
+const userStatusTTL = 5 * time.Minute
+
+// User implements domain.UserDirectory over the user service's gRPC
+// API, with a small TTL cache so hot paths don't call out per request.
+type User struct {
+	client userv1.UserServiceClient
+
+	mu    sync.Mutex
+	cache map[uuid.UUID]cachedStatus
+}
+
+type cachedStatus struct {
+	status   domain.UserStatus
+	cachedAt time.Time
+}
+
+// NewUser builds the client.
+func NewUser(conn grpc.ClientConnInterface) *User {
+	return &User{
+		client: userv1.NewUserServiceClient(conn),
+		cache:  make(map[uuid.UUID]cachedStatus),
+	}
+}
+
+// Status returns the user's standing, serving from cache when fresh.
+func (u *User) Status(ctx context.Context, userID uuid.UUID) (domain.UserStatus, error) {
+	u.mu.Lock()
+	if entry, ok := u.cache[userID]; ok && time.Since(entry.cachedAt) < userStatusTTL {
+		u.mu.Unlock()
+		return entry.status, nil
+	}
+	u.mu.Unlock()
+
+	resp, err := u.client.GetUserStatus(ctx, &userv1.GetUserStatusRequest{UserId: userID.String()})
+	var result domain.UserStatus
+	switch {
+	case status.Code(err) == codes.NotFound:
+		result = domain.UserStatus{}
+	case err != nil:
+		return domain.UserStatus{}, fmt.Errorf("user GetUserStatus: %w", err)
+	default:
+		result = domain.UserStatus{
+			Exists:      true,
+			KYCEligible: resp.GetKycStatus() == "verified" && !resp.GetBlocked(),
+		}
+	}
+
+	u.mu.Lock()
+	u.cache[userID] = cachedStatus{status: result, cachedAt: time.Now()}
+	u.mu.Unlock()
+	return result, nil
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BusinessStatus is the approval state of an online business.
+type BusinessStatus string
+
+const (
+	// BusinessPending awaits review.
+	BusinessPending BusinessStatus = "pending"
+	// BusinessApproved may transact.
+	BusinessApproved BusinessStatus = "approved"
+	// BusinessRejected failed review.
+	BusinessRejected BusinessStatus = "rejected"
+)
+
+// OnlineBusiness is one merchant website or application.
+type OnlineBusiness struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	URL         string
+	WebsiteName string
+	Status      BusinessStatus
+	// Guild is the business category (reference data).
+	Guild      string
+	LicenseID  string
+	PostalCode string
+	Phones     []string
+	TaxID      string
+	IBAN       string
+	// BankName is derived from the IBAN's bank code when financial data is
+	// saved.
+	BankName string
+	// ExternalRef keys bulk-imported rows to the source system so
+	// re-runs are idempotent; empty for businesses created through the
+	// API.
+	ExternalRef string
+	// EnamadID is the merchant's e-commerce trust seal; EnamadStatus and
+	// EnamadExpiresAt reflect the last verification against enamad.ir.
+	EnamadID        string
+	EnamadStatus    EnamadStatus
+	EnamadExpiresAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	// CreatedBy and UpdatedBy attribute writes to the acting user; they
+	// are null on rows that predate auditing.
+	CreatedBy uuid.NullUUID
+	UpdatedBy uuid.NullUUID
+}
+
+// EnamadStatus is the verification state of a business's enamad seal.
+type EnamadStatus string
+
+const (
+	// EnamadUnverified means no verification has been attempted.
+	EnamadUnverified EnamadStatus = "unverified"
+	// EnamadVerified means the seal matched the business URL.
+	EnamadVerified EnamadStatus = "verified"
+	// EnamadFailed means the last verification attempt rejected the seal.
+	EnamadFailed EnamadStatus = "failed"
+)
+
+// EnamadVerification is the outcome of checking a seal against a URL.
+type EnamadVerification struct {
+	Valid     bool
+	ExpiresAt time.Time
+}
+
+// EnamadVerifier checks an enamad seal against the business URL.
+type EnamadVerifier interface {
+	Verify(ctx context.Context, enamadID, url string) (EnamadVerification, error)
+}
+
+// BusinessFilter narrows business listings; zero values mean "any".
+type BusinessFilter struct {
+	Status BusinessStatus
+	UserID uuid.UUID
+	// CreatedFrom and CreatedTo bound CreatedAt.
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+// BusinessCursor is the keyset position of the last row of a page;
+// listing continues strictly after it. (CreatedAt, ID) is a total order
+// because IDs are unique.
+type BusinessCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// OnlineBusinessRepository persists online businesses.
+type OnlineBusinessRepository interface {
+	Create(ctx context.Context, b *OnlineBusiness) error
+	GetByID(ctx context.Context, id uuid.UUID) (*OnlineBusiness, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*OnlineBusiness, error)
+	// UpdateInfo persists the registry fields (guild, license, postal
+	// code, phones) and bumps updated_at.
+	UpdateInfo(ctx context.Context, b *OnlineBusiness) (*OnlineBusiness, error)
+	// UpdateFinancial persists tax ID, IBAN, and the derived bank name.
+	UpdateFinancial(ctx context.Context, b *OnlineBusiness) (*OnlineBusiness, error)
+	// IBANInUse reports whether another business (excluding excludeID)
+	// already registered the IBAN.
+	IBANInUse(ctx context.Context, iban string, excludeID uuid.UUID) (bool, error)
+	// List pages businesses matching the filter using keyset pagination;
+	// a nil cursor starts from the newest (or oldest when ascending).
+	List(ctx context.Context, filter BusinessFilter, cursor *BusinessCursor, limit int, ascending bool) ([]*OnlineBusiness, error)
+	// Delete soft-deletes the business; deleted rows are invisible to
+	// every read until restored.
+	Delete(ctx context.Context, id, deletedBy uuid.UUID) error
+	// Restore undoes a soft delete. ErrBusinessNotFound covers both
+	// unknown and not-deleted IDs.
+	Restore(ctx context.Context, id, restoredBy uuid.UUID) (*OnlineBusiness, error)
+	// Search finds businesses whose URL or website name fuzzy-matches the
+	// query, best matches first. A non-nil userID restricts to one owner.
+	Search(ctx context.Context, query string, userID uuid.UUID, limit, offset int) ([]*OnlineBusiness, error)
+	// ExternalRefExists reports whether a bulk-import reference was
+	// already imported.
+	ExternalRefExists(ctx context.Context, ref string) (bool, error)
+	// UpdateEnamad records the outcome of an enamad verification.
+	UpdateEnamad(ctx context.Context, id uuid.UUID, enamadID string, status EnamadStatus, expiresAt *time.Time, updatedBy uuid.UUID) (*OnlineBusiness, error)
+	// SetStatus moves the business through the approval workflow.
+	SetStatus(ctx context.Context, id uuid.UUID, status BusinessStatus, updatedBy uuid.UUID) (*OnlineBusiness, error)
+	// ReassignOwner moves every business of one owner to another and
+	// returns how many moved. Backs account-merge tooling.
+	ReassignOwner(ctx context.Context, fromUserID, toUserID, updatedBy uuid.UUID) (int64, error)
+}
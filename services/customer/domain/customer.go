@@ -0,0 +1,55 @@
+// Package domain holds the customer service's entities and repository
+// contracts.
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CustomerType distinguishes individuals from legal entities.
+type CustomerType string
+
+const (
+	// CustomerIndividual is a natural person.
+	CustomerIndividual CustomerType = "individual"
+	// CustomerCorporate is a registered legal entity.
+	CustomerCorporate CustomerType = "corporate"
+)
+
+// Customer is a merchant identity owning online businesses.
+type Customer struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Type   CustomerType
+	// NationalID is the personal code melli for individuals or the legal
+	// shenase melli for corporates.
+	NationalID string
+	// Version increments on every update and guards concurrent writes.
+	Version   int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// CreatedBy and UpdatedBy attribute writes to the acting user; they
+	// are null on rows that predate auditing.
+	CreatedBy uuid.NullUUID
+	UpdatedBy uuid.NullUUID
+}
+
+// CustomerRepository persists customers.
+type CustomerRepository interface {
+	Create(ctx context.Context, c *Customer) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Customer, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*Customer, error)
+	// UpdateNationalID sets the national ID if the stored version still
+	// matches; ErrConcurrentUpdate otherwise.
+	UpdateNationalID(ctx context.Context, id uuid.UUID, nationalID string, version int64) (*Customer, error)
+	// SetType changes the customer type. Switching type clears the
+	// national ID, because a shenase melli is meaningless on an individual
+	// and a code melli on a corporate.
+	SetType(ctx context.Context, id uuid.UUID, customerType CustomerType) (*Customer, error)
+	// Search finds customers by exact national ID and/or user ID; zero
+	// values mean "any".
+	Search(ctx context.Context, nationalID string, userID uuid.UUID, limit, offset int) ([]*Customer, error)
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DocumentKind classifies an uploaded business document.
+type DocumentKind string
+
+const (
+	// DocumentLicenseScan is a scan of the business license.
+	DocumentLicenseScan DocumentKind = "license_scan"
+	// DocumentStatute is the corporate statute (asasname).
+	DocumentStatute DocumentKind = "statute"
+	// DocumentOther covers anything support asks for ad hoc.
+	DocumentOther DocumentKind = "other"
+)
+
+// ScanStatus is the virus-scan state of a document.
+type ScanStatus string
+
+const (
+	// ScanPending means the object was presigned but not yet scanned.
+	ScanPending ScanStatus = "pending"
+	// ScanClean means the scanner accepted the object.
+	ScanClean ScanStatus = "clean"
+	// ScanInfected means the scanner rejected the object; it must never
+	// be served.
+	ScanInfected ScanStatus = "infected"
+)
+
+var (
+	// ErrDocumentNotFound is returned for unknown document IDs.
+	ErrDocumentNotFound = errors.New("customer: document not found")
+	// ErrDocumentInfected is returned when a confirmed upload failed the
+	// virus scan.
+	ErrDocumentInfected = errors.New("customer: document failed the virus scan")
+	// ErrDocumentsRequired is returned when approval is attempted before
+	// the required documents are uploaded and clean.
+	ErrDocumentsRequired = errors.New("customer: required documents are missing")
+	// ErrUploadMissing is returned when a confirm references an object
+	// that was never uploaded.
+	ErrUploadMissing = errors.New("customer: no object was uploaded")
+	// ErrUploadMismatch is returned when the stored object does not
+	// match what the upload request declared.
+	ErrUploadMismatch = errors.New("customer: uploaded object does not match the declared document")
+)
+
+// Document is the metadata of one uploaded business document; the bytes
+// live in object storage under ObjectKey.
+type Document struct {
+	ID          uuid.UUID
+	BusinessID  uuid.UUID
+	Kind        DocumentKind
+	ObjectKey   string
+	ContentType string
+	SizeBytes   int64
+	ScanStatus  ScanStatus
+	UploadedBy  uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// DocumentRepository persists document metadata.
+type DocumentRepository interface {
+	Create(ctx context.Context, d *Document) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Document, error)
+	ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*Document, error)
+	SetScanStatus(ctx context.Context, id uuid.UUID, status ScanStatus) (*Document, error)
+	// HasClean reports whether the business has at least one clean
+	// document of the given kind.
+	HasClean(ctx context.Context, businessID uuid.UUID, kind DocumentKind) (bool, error)
+}
+
+// ObjectInfo is what the store reports about an uploaded object.
+type ObjectInfo struct {
+	ContentType string
+	SizeBytes   int64
+}
+
+// ObjectStore issues presigned URLs for direct-to-storage uploads, so
+// document bytes never pass through the service.
+type ObjectStore interface {
+	PresignPut(key, contentType string, expiry time.Duration) (string, error)
+	// Stat reports the stored object's actual size and content type,
+	// or ErrUploadMissing when nothing was uploaded under key.
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+}
+
+// VirusScanner checks an uploaded object. Implementations wrap whatever
+// scanner the deployment runs (ClamAV, a vendor API).
+type VirusScanner interface {
+	ScanObject(ctx context.Context, key string) (clean bool, err error)
+}
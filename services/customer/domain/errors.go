@@ -0,0 +1,31 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrCustomerNotFound is returned for unknown customer IDs.
+	ErrCustomerNotFound = errors.New("customer: customer not found")
+	// ErrBusinessNotFound is returned for unknown business IDs.
+	ErrBusinessNotFound = errors.New("customer: business not found")
+	// ErrConcurrentUpdate is returned when an optimistic-concurrency
+	// version check fails; the caller should re-read and retry.
+	ErrConcurrentUpdate = errors.New("customer: record was updated concurrently")
+	// ErrInvalidNationalID is returned when a national ID fails format or
+	// checksum validation.
+	ErrInvalidNationalID = errors.New("customer: invalid national id")
+	// ErrNotCorporate is returned when a corporate-only operation targets
+	// an individual customer.
+	ErrNotCorporate = errors.New("customer: customer is not corporate")
+	// ErrNotOwner is returned when the authenticated user does not own the
+	// targeted record.
+	ErrNotOwner = errors.New("customer: record belongs to another user")
+	// ErrEnamadNotVerified is returned when approval is attempted on a
+	// business without a current, valid enamad verification.
+	ErrEnamadNotVerified = errors.New("customer: enamad is not verified")
+	// ErrDuplicateURL is returned when another business already
+	// registered the URL.
+	ErrDuplicateURL = errors.New("customer: url already registered")
+	// ErrDuplicateExternalRef is returned when a bulk-import reference
+	// was already imported.
+	ErrDuplicateExternalRef = errors.New("customer: external reference already imported")
+)
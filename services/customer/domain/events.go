@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Customer domain event names. The notification service and analytics
+// key their consumers on these.
+const (
+	EventBusinessCreated     = "business.created"
+	EventBusinessApproved    = "business.approved"
+	EventBusinessInfoUpdated = "business.info_updated"
+)
+
+// Event is one customer domain event handed to the publisher.
+type Event struct {
+	Name       string
+	BusinessID uuid.UUID
+	UserID     uuid.UUID
+	OccurredAt time.Time
+	Payload    map[string]string
+}
+
+// EventPublisher emits customer domain events for other services to
+// consume asynchronously. Implementations go through the transactional
+// outbox so events are never lost for committed writes.
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
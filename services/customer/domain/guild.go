@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrUnknownGuild is returned when a business references a guild code
+// that is not in the reference table.
+var ErrUnknownGuild = errors.New("customer: unknown guild code")
+
+// Guild is one business category from the reference table. Businesses
+// store the code; titles are for display.
+type Guild struct {
+	Code  string
+	Title string
+	// Active guilds are offered to new businesses; inactive ones remain
+	// valid on existing records but can no longer be selected.
+	Active bool
+}
+
+// GuildRepository reads the guild reference data.
+type GuildRepository interface {
+	// List returns guilds ordered by title; activeOnly drops retired ones.
+	List(ctx context.Context, activeOnly bool) ([]*Guild, error)
+	// Exists reports whether the code names an active guild.
+	Exists(ctx context.Context, code string) (bool, error)
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrInvalidIBAN is returned for IBANs failing format or checksum.
+	ErrInvalidIBAN = errors.New("customer: invalid IBAN")
+	// ErrDuplicateIBAN is returned when another business already uses the
+	// IBAN; settlement accounts must be unique across merchants.
+	ErrDuplicateIBAN = errors.New("customer: IBAN already registered to another business")
+)
+
+// ibanBanks maps the three-digit bank code inside an IR IBAN to the bank
+// name, for display and settlement routing.
+var ibanBanks = map[string]string{
+	"012": "Mellat",
+	"013": "Refah",
+	"014": "Maskan",
+	"015": "Sepah",
+	"016": "Keshavarzi",
+	"017": "Melli",
+	"018": "Tejarat",
+	"019": "Saderat",
+	"020": "Tosee Saderat",
+	"021": "Post Bank",
+	"053": "Karafarin",
+	"054": "Parsian",
+	"055": "Eghtesad Novin",
+	"056": "Saman",
+	"057": "Pasargad",
+	"058": "Sarmayeh",
+	"061": "Shahr",
+	"062": "Ayandeh",
+	"064": "Gardeshgari",
+	"066": "Dey",
+	"069": "Iran Zamin",
+	"070": "Resalat",
+}
+
+// NormalizeIBAN uppercases and strips spaces so user-pasted IBANs
+// compare equal.
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.ReplaceAll(iban, " ", ""))
+}
+
+// ValidateIranianIBAN checks an IR IBAN: 26 characters, IR prefix, and
+// the ISO 13616 mod-97 checksum.
+func ValidateIranianIBAN(iban string) bool {
+	iban = NormalizeIBAN(iban)
+	if len(iban) != 26 || !strings.HasPrefix(iban, "IR") || !allDigits(iban[2:]) {
+		return false
+	}
+	// Move the country code and check digits to the end; IR maps to 1827.
+	rearranged := iban[4:] + "1827" + iban[2:4]
+	remainder := 0
+	for i := 0; i < len(rearranged); i++ {
+		remainder = (remainder*10 + int(rearranged[i]-'0')) % 97
+	}
+	return remainder == 1
+}
+
+// BankFromIBAN returns the issuing bank's name, or "" when the bank code
+// is unknown. The code occupies positions 4-7 of a normalized IR IBAN.
+func BankFromIBAN(iban string) string {
+	iban = NormalizeIBAN(iban)
+	if len(iban) != 26 {
+		return ""
+	}
+	return ibanBanks[iban[4:7]]
+}
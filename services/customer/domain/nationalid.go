@@ -0,0 +1,68 @@
+package domain
+
+// National ID validation for both customer types. Both identifiers are
+// fixed-length digit strings with a trailing check digit.
+
+// ValidatePersonalNationalID checks a 10-digit code melli: the check
+// digit equals sum(d[i] * (10-i)) mod 11, or 11 minus that when the
+// remainder is 2 or more.
+func ValidatePersonalNationalID(id string) bool {
+	if len(id) != 10 || !allDigits(id) {
+		return false
+	}
+	// All-same-digit values pass the checksum but are not real IDs.
+	if allSame(id) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(id[i]-'0') * (10 - i)
+	}
+	remainder := sum % 11
+	check := int(id[9] - '0')
+	if remainder < 2 {
+		return check == remainder
+	}
+	return check == 11-remainder
+}
+
+// ValidateLegalNationalID checks an 11-digit shenase melli (legal entity
+// identifier): each digit is offset by the decade digit plus two, weighted
+// cyclically, and compared against the trailing check digit.
+func ValidateLegalNationalID(id string) bool {
+	if len(id) != 11 || !allDigits(id) {
+		return false
+	}
+	if allSame(id) {
+		return false
+	}
+	offset := int(id[9]-'0') + 2
+	weights := [10]int{29, 27, 23, 19, 17, 29, 27, 23, 19, 17}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		sum += (int(id[i]-'0') + offset) * weights[i]
+	}
+	remainder := sum % 11
+	if remainder == 10 {
+		remainder = 0
+	}
+	return remainder == int(id[10]-'0')
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func allSame(s string) bool {
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[0] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// StatusCount is the number of businesses in one approval state.
+type StatusCount struct {
+	Status BusinessStatus
+	Count  int64
+}
+
+// GuildCount is the number of businesses in one guild.
+type GuildCount struct {
+	Guild string
+	Count int64
+}
+
+// DailyCount is the number of businesses created on one calendar day.
+type DailyCount struct {
+	Day   time.Time
+	Count int64
+}
+
+// ApprovalLatency holds percentiles of the time between business
+// creation and first approval. Zero values mean no business was
+// approved in the window.
+type ApprovalLatency struct {
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+}
+
+// BusinessReport aggregates onboarding metrics for one time window.
+type BusinessReport struct {
+	From         time.Time
+	To           time.Time
+	ByStatus     []StatusCount
+	ByGuild      []GuildCount
+	CreatedByDay []DailyCount
+	Latency      ApprovalLatency
+}
+
+// ReportRepository computes business aggregates in the database; the
+// service never pulls raw rows to count them in Go.
+type ReportRepository interface {
+	CountByStatus(ctx context.Context) ([]StatusCount, error)
+	CountByGuild(ctx context.Context) ([]GuildCount, error)
+	// CreatedByDay buckets creations per day in [from, to).
+	CreatedByDay(ctx context.Context, from, to time.Time) ([]DailyCount, error)
+	// ApprovalLatency computes latency percentiles over businesses
+	// approved in [from, to).
+	ApprovalLatency(ctx context.Context, from, to time.Time) (ApprovalLatency, error)
+}
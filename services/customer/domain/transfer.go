@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransferStatus is the state of an ownership transfer.
+type TransferStatus string
+
+const (
+	// TransferPending awaits the new owner's OTP confirmation.
+	TransferPending TransferStatus = "pending"
+	// TransferCompleted moved the business to the new owner.
+	TransferCompleted TransferStatus = "completed"
+	// TransferCanceled was withdrawn by the initiator or timed out.
+	TransferCanceled TransferStatus = "canceled"
+)
+
+var (
+	// ErrTransferNotFound is returned for unknown transfer IDs.
+	ErrTransferNotFound = errors.New("customer: transfer not found")
+	// ErrTransferNotPending is returned when a terminal transfer is acted
+	// on.
+	ErrTransferNotPending = errors.New("customer: transfer is not pending")
+	// ErrTransferExists is returned when a business already has a pending
+	// transfer.
+	ErrTransferExists = errors.New("customer: business already has a pending transfer")
+	// ErrTransferOTPInvalid is returned for a wrong or expired
+	// confirmation code.
+	ErrTransferOTPInvalid = errors.New("customer: invalid or expired transfer code")
+)
+
+// BusinessTransfer is one ownership transfer in flight. Completed and
+// canceled rows stay in the table as the audit trail of every ownership
+// change.
+type BusinessTransfer struct {
+	ID         uuid.UUID
+	BusinessID uuid.UUID
+	FromUserID uuid.UUID
+	ToUserID   uuid.UUID
+	Status     TransferStatus
+	// OTPHash is the SHA-256 of the confirmation code sent to the new
+	// owner; the plaintext is never stored.
+	OTPHash      string
+	OTPExpiresAt *time.Time
+	// Attempts counts failed confirmations; the transfer cancels itself
+	// after too many.
+	Attempts  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TransferRepository persists ownership transfers.
+type TransferRepository interface {
+	// Create inserts a pending transfer; ErrTransferExists if the business
+	// already has one.
+	Create(ctx context.Context, t *BusinessTransfer) error
+	GetByID(ctx context.Context, id uuid.UUID) (*BusinessTransfer, error)
+	// SetOTP stores a fresh code hash and its expiry.
+	SetOTP(ctx context.Context, id uuid.UUID, otpHash string, expiresAt time.Time) error
+	// RecordAttempt increments the failed-attempt counter and returns the
+	// new count.
+	RecordAttempt(ctx context.Context, id uuid.UUID) (int, error)
+	// Complete atomically marks the transfer completed and moves the
+	// business to the new owner.
+	Complete(ctx context.Context, t *BusinessTransfer) error
+	// Cancel marks a pending transfer canceled.
+	Cancel(ctx context.Context, id uuid.UUID) error
+}
+
+// OTPSender delivers transfer confirmation codes, normally through the
+// notification service.
+type OTPSender interface {
+	SendTransferOTP(ctx context.Context, mobile, code string) error
+}
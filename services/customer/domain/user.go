@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrUserUnknown is returned when a referenced user does not exist in
+	// the user service.
+	ErrUserUnknown = errors.New("customer: user does not exist")
+	// ErrUserNotEligible is returned when a user may not own businesses
+	// (KYC incomplete or account blocked).
+	ErrUserNotEligible = errors.New("customer: user is not eligible to own a business")
+)
+
+// UserStatus is the sibling-service view of one user's standing.
+type UserStatus struct {
+	Exists bool
+	// KYCEligible means the user may own businesses: KYC verified and
+	// not blocked.
+	KYCEligible bool
+}
+
+// UserDirectory validates user references against the user service,
+// instead of trusting raw IDs from requests or claims.
+type UserDirectory interface {
+	Status(ctx context.Context, userID uuid.UUID) (UserStatus, error)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrInvalidPostalCode is returned for malformed Iranian postal codes.
+	ErrInvalidPostalCode = errors.New("customer: invalid postal code")
+	// ErrInvalidPhone is returned for phone numbers that survive
+	// normalization but still are not plausible Iranian numbers.
+	ErrInvalidPhone = errors.New("customer: invalid phone number")
+)
+
+// ValidatePostalCode checks an Iranian 10-digit postal code. The postal
+// system never issues all-equal or zero-leading codes.
+func ValidatePostalCode(code string) bool {
+	if len(code) != 10 || !allDigits(code) {
+		return false
+	}
+	if allSame(code) || code[0] == '0' {
+		return false
+	}
+	return true
+}
+
+// NormalizePhone canonicalizes an Iranian phone number to its domestic
+// 0-prefixed form: "+98912...", "0098912...", and "912..." all become
+// "0912...". It returns ErrInvalidPhone when the result is not a
+// plausible mobile (11 digits) or landline (11 digits with area code).
+func NormalizePhone(phone string) (string, error) {
+	cleaned := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		if r == '+' {
+			return r
+		}
+		return -1 // drop spaces, dashes, parentheses
+	}, phone)
+
+	switch {
+	case strings.HasPrefix(cleaned, "+98"):
+		cleaned = "0" + cleaned[3:]
+	case strings.HasPrefix(cleaned, "0098"):
+		cleaned = "0" + cleaned[4:]
+	case strings.HasPrefix(cleaned, "98") && len(cleaned) == 12:
+		cleaned = "0" + cleaned[2:]
+	case !strings.HasPrefix(cleaned, "0") && len(cleaned) == 10:
+		cleaned = "0" + cleaned
+	}
+
+	if len(cleaned) != 11 || !allDigits(cleaned) || cleaned[0] != '0' || cleaned[1] == '0' {
+		return "", ErrInvalidPhone
+	}
+	return cleaned, nil
+}
@@ -0,0 +1,154 @@
+// Package enamad verifies merchant e-commerce trust seals against the
+// enamad.ir registry.
+package enamad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// Config configures the Enamad client.
+type Config struct {
+	BaseURL string
+	APIKey  string
+	Timeout time.Duration
+	// CacheTTL bounds how long a verification result is reused without
+	// hitting the registry again. Zero means DefaultCacheTTL.
+	CacheTTL time.Duration
+	// Retries is how many times a failed call is retried. Zero means
+	// DefaultRetries.
+	Retries int
+}
+
+// Default client tuning. Enamad results change rarely, so a long cache
+// is safe; the registry is flaky enough that a couple of retries pay off.
+const (
+	DefaultCacheTTL = time.Hour
+	DefaultRetries  = 2
+	retryBaseDelay  = 500 * time.Millisecond
+)
+
+// Client talks to the Enamad registry. It implements
+// domain.EnamadVerifier.
+type Client struct {
+	http     *common.HTTPClient
+	cacheTTL time.Duration
+	retries  int
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	result   domain.EnamadVerification
+	cachedAt time.Time
+}
+
+// New builds an Enamad client.
+func New(cfg Config) *Client {
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = DefaultCacheTTL
+	}
+	if cfg.Retries <= 0 {
+		cfg.Retries = DefaultRetries
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Client{
+		http: common.NewHTTPClient(
+			common.WithBaseURL(cfg.BaseURL),
+			common.WithClientTimeout(cfg.Timeout),
+			common.WithDefaultHeader("Authorization", "Bearer "+cfg.APIKey),
+		),
+		cacheTTL: cfg.CacheTTL,
+		retries:  cfg.Retries,
+		cache:    make(map[string]cacheEntry),
+	}
+}
+
+// sealResponse is the registry's answer for one seal.
+type sealResponse struct {
+	Status    string `json:"status"`
+	Domain    string `json:"domain"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+// Verify checks the seal against the business URL's host. Results are
+// cached per (seal, host) for CacheTTL and transient failures are
+// retried with backoff.
+func (c *Client) Verify(ctx context.Context, enamadID, rawURL string) (domain.EnamadVerification, error) {
+	host, err := hostOf(rawURL)
+	if err != nil {
+		return domain.EnamadVerification{}, err
+	}
+
+	key := enamadID + "|" + host
+	c.mu.Lock()
+	if entry, ok := c.cache[key]; ok && time.Since(entry.cachedAt) < c.cacheTTL {
+		c.mu.Unlock()
+		return entry.result, nil
+	}
+	c.mu.Unlock()
+
+	var body []byte
+	for attempt := 0; ; attempt++ {
+		resp, reqErr := c.http.Get(ctx, "/api/v1/seals/"+url.PathEscape(enamadID),
+			common.WithQuery("domain", host))
+		if reqErr == nil {
+			body, reqErr = common.ReadResponseBody(resp)
+		}
+		if reqErr == nil {
+			break
+		}
+		if attempt >= c.retries || ctx.Err() != nil {
+			return domain.EnamadVerification{}, fmt.Errorf("enamad: verify %s: %w", enamadID, reqErr)
+		}
+		select {
+		case <-time.After(retryBaseDelay << attempt):
+		case <-ctx.Done():
+			return domain.EnamadVerification{}, ctx.Err()
+		}
+	}
+
+	var seal sealResponse
+	if err := json.Unmarshal(body, &seal); err != nil {
+		return domain.EnamadVerification{}, fmt.Errorf("enamad: decode response: %w", err)
+	}
+	result := domain.EnamadVerification{
+		Valid: seal.Status == "active" && strings.EqualFold(seal.Domain, host),
+	}
+	if seal.ExpiresAt != "" {
+		if expires, err := time.Parse(time.RFC3339, seal.ExpiresAt); err == nil {
+			result.ExpiresAt = expires
+			if !expires.After(time.Now()) {
+				result.Valid = false
+			}
+		}
+	}
+
+	c.mu.Lock()
+	c.cache[key] = cacheEntry{result: result, cachedAt: time.Now()}
+	c.mu.Unlock()
+	return result, nil
+}
+
+// hostOf extracts the lowercase host from a business URL.
+func hostOf(rawURL string) (string, error) {
+	if !strings.Contains(rawURL, "://") {
+		rawURL = "https://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return "", fmt.Errorf("enamad: business URL %q has no host", rawURL)
+	}
+	return strings.ToLower(u.Hostname()), nil
+}
@@ -0,0 +1,56 @@
+// Package events adapts customer domain events onto the core broker.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/K-H-Tech/infera/core/broker"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// Topic is where all customer domain events land.
+const Topic = "customer.events"
+
+// Publisher implements domain.EventPublisher on top of the broker outbox,
+// so events survive crashes and are only published for committed writes.
+type Publisher struct {
+	out broker.Publisher
+}
+
+// NewPublisher builds the customer event publisher. out is usually the
+// service's outbox.
+func NewPublisher(out broker.Publisher) *Publisher {
+	return &Publisher{out: out}
+}
+
+// envelope is the wire shape of a customer event payload.
+type envelope struct {
+	Name       string            `json:"name"`
+	BusinessID string            `json:"business_id"`
+	UserID     string            `json:"user_id"`
+	OccurredAt string            `json:"occurred_at"`
+	Payload    map[string]string `json:"payload,omitempty"`
+}
+
+// Publish encodes the event and hands it to the outbox.
+func (p *Publisher) Publish(ctx context.Context, event domain.Event) error {
+	body, err := json.Marshal(envelope{
+		Name:       event.Name,
+		BusinessID: event.BusinessID.String(),
+		UserID:     event.UserID.String(),
+		OccurredAt: event.OccurredAt.UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+		Payload:    event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", event.Name, err)
+	}
+	return p.out.Publish(ctx, broker.Message{
+		Topic:      Topic,
+		Key:        event.BusinessID.String(),
+		Payload:    body,
+		Headers:    map[string]string{"event": event.Name},
+		OccurredAt: event.OccurredAt,
+	})
+}
@@ -0,0 +1,253 @@
+// Package handler adapts the customer service to its gRPC API.
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+	"github.com/K-H-Tech/infera/services/customer/service"
+)
+
+// CustomerHandler adapts the customer use cases to the customer.v1 gRPC
+// API.
+type CustomerHandler struct {
+	customerv1.UnimplementedCustomerServiceServer
+
+	customers *service.CustomerService
+	log       *slog.Logger
+}
+
+// NewCustomerHandler builds the customer handler.
+func NewCustomerHandler(customers *service.CustomerService, log *slog.Logger) *CustomerHandler {
+	return &CustomerHandler{customers: customers, log: log}
+}
+
+func (h *CustomerHandler) CreateOnlineBusiness(ctx context.Context, req *customerv1.CreateOnlineBusinessRequest) (*customerv1.CreateOnlineBusinessResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if req.GetUrl() == "" || req.GetWebsiteName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "url and website_name are required")
+	}
+	business, err := h.customers.CreateBusiness(ctx, c.UserID, req.GetUrl(), req.GetWebsiteName())
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.CreateOnlineBusinessResponse{Business: businessToProto(business)}, nil
+}
+
+func (h *CustomerHandler) GetMyBusinesses(ctx context.Context, req *customerv1.GetMyBusinessesRequest) (*customerv1.GetMyBusinessesResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businesses, err := h.customers.MyBusinesses(ctx, c.UserID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	resp := &customerv1.GetMyBusinessesResponse{}
+	for _, b := range businesses {
+		resp.Businesses = append(resp.Businesses, businessToProto(b))
+	}
+	return resp, nil
+}
+
+func (h *CustomerHandler) UpdateCorporateCustomerNationalID(ctx context.Context, req *customerv1.UpdateCorporateCustomerNationalIDRequest) (*customerv1.UpdateCorporateCustomerNationalIDResponse, error) {
+	customerID, err := uuid.Parse(req.GetCustomerId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid customer_id")
+	}
+	customer, err := h.customers.UpdateCorporateNationalID(ctx, customerID, req.GetNationalId(), req.GetVersion())
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.UpdateCorporateCustomerNationalIDResponse{
+		Customer: customerToProto(customer),
+	}, nil
+}
+
+func (h *CustomerHandler) SetCustomerAsIndividual(ctx context.Context, req *customerv1.SetCustomerAsIndividualRequest) (*customerv1.SetCustomerAsIndividualResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	customerID, err := uuid.Parse(req.GetCustomerId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid customer_id")
+	}
+	customer, err := h.customers.SetAsIndividual(ctx, c.UserID, customerID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.SetCustomerAsIndividualResponse{Customer: customerToProto(customer)}, nil
+}
+
+func (h *CustomerHandler) UpdateBusinessInfo(ctx context.Context, req *customerv1.UpdateBusinessInfoRequest) (*customerv1.UpdateBusinessInfoResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+
+	// Without a mask every field is updated, matching the old full-update
+	// behavior; with a mask only the named paths change.
+	update := service.BusinessInfoUpdate{}
+	for _, path := range maskPaths(req.GetUpdateMask().GetPaths(), "guild", "license_id", "postal_code", "phones") {
+		switch path {
+		case "guild":
+			v := req.GetGuild()
+			update.Guild = &v
+		case "license_id":
+			v := req.GetLicenseId()
+			update.LicenseID = &v
+		case "postal_code":
+			v := req.GetPostalCode()
+			update.PostalCode = &v
+		case "phones":
+			update.Phones = req.GetPhones()
+			if update.Phones == nil {
+				update.Phones = []string{}
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+
+	business, err := h.customers.UpdateBusinessInfo(ctx, c.UserID, businessID, update)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.UpdateBusinessInfoResponse{Business: businessToProto(business)}, nil
+}
+
+// maskPaths returns the mask's paths, or every known path when the mask
+// is absent.
+func maskPaths(paths []string, all ...string) []string {
+	if len(paths) == 0 {
+		return all
+	}
+	return paths
+}
+
+func (h *CustomerHandler) UpdateFinancialData(ctx context.Context, req *customerv1.UpdateFinancialDataRequest) (*customerv1.UpdateFinancialDataResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	business, err := h.customers.UpdateFinancialData(ctx, c.UserID, businessID, req.GetTaxId(), req.GetIban())
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.UpdateFinancialDataResponse{Business: businessToProto(business)}, nil
+}
+
+func (h *CustomerHandler) DeleteOnlineBusiness(ctx context.Context, req *customerv1.DeleteOnlineBusinessRequest) (*customerv1.DeleteOnlineBusinessResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	if err := h.customers.DeleteBusiness(ctx, c.UserID, businessID); err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.DeleteOnlineBusinessResponse{}, nil
+}
+
+func (h *CustomerHandler) RestoreOnlineBusiness(ctx context.Context, req *customerv1.RestoreOnlineBusinessRequest) (*customerv1.RestoreOnlineBusinessResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.PermissionDenied, "restore requires the backoffice role")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	business, err := h.customers.RestoreBusiness(ctx, c.UserID, businessID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.RestoreOnlineBusinessResponse{Business: businessToProto(business)}, nil
+}
+
+func customerToProto(c *domain.Customer) *customerv1.Customer {
+	return &customerv1.Customer{
+		Id:         c.ID.String(),
+		UserId:     c.UserID.String(),
+		Type:       string(c.Type),
+		NationalId: c.NationalID,
+		Version:    c.Version,
+	}
+}
+
+func businessToProto(b *domain.OnlineBusiness) *customerv1.OnlineBusiness {
+	p := &customerv1.OnlineBusiness{
+		Id:           b.ID.String(),
+		UserId:       b.UserID.String(),
+		Url:          b.URL,
+		WebsiteName:  b.WebsiteName,
+		Status:       string(b.Status),
+		Guild:        b.Guild,
+		LicenseId:    b.LicenseID,
+		PostalCode:   b.PostalCode,
+		Phones:       b.Phones,
+		TaxId:        b.TaxID,
+		Iban:         b.IBAN,
+		BankName:     b.BankName,
+		EnamadId:     b.EnamadID,
+		EnamadStatus: string(b.EnamadStatus),
+	}
+	if b.EnamadExpiresAt != nil {
+		p.EnamadExpiresAt = timestamppb.New(*b.EnamadExpiresAt)
+	}
+	return p
+}
+
+func mapCustomerError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCustomerNotFound):
+		return status.Error(codes.NotFound, "customer not found")
+	case errors.Is(err, domain.ErrBusinessNotFound):
+		return status.Error(codes.NotFound, "business not found")
+	case errors.Is(err, domain.ErrInvalidNationalID), errors.Is(err, domain.ErrNotCorporate),
+		errors.Is(err, domain.ErrInvalidPostalCode), errors.Is(err, domain.ErrInvalidPhone),
+		errors.Is(err, domain.ErrInvalidIBAN), errors.Is(err, domain.ErrUnknownGuild):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrNotOwner):
+		// NotFound rather than PermissionDenied, to avoid confirming the
+		// record exists to a non-owner.
+		return status.Error(codes.NotFound, "customer not found")
+	case errors.Is(err, domain.ErrDuplicateIBAN), errors.Is(err, domain.ErrDuplicateURL):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrUserUnknown):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrUserNotEligible):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrConcurrentUpdate):
+		return status.Error(codes.Aborted, "customer was updated concurrently; re-read and retry")
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
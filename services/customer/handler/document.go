@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+	"github.com/K-H-Tech/infera/services/customer/service"
+)
+
+func (h *CustomerHandler) RequestDocumentUpload(ctx context.Context, req *customerv1.RequestDocumentUploadRequest) (*customerv1.RequestDocumentUploadResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	kind := domain.DocumentKind(req.GetKind())
+	switch kind {
+	case domain.DocumentLicenseScan, domain.DocumentStatute, domain.DocumentOther:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown document kind %q", req.GetKind())
+	}
+	document, uploadURL, err := h.customers.RequestDocumentUpload(ctx,
+		c.UserID, businessID, kind, req.GetContentType(), req.GetSizeBytes())
+	if err != nil {
+		if errors.Is(err, service.ErrStorageUnavailable) {
+			return nil, status.Error(codes.Unavailable, "document storage is not available")
+		}
+		return nil, mapDocumentError(err)
+	}
+	return &customerv1.RequestDocumentUploadResponse{
+		Document:  documentToProto(document),
+		UploadUrl: uploadURL,
+	}, nil
+}
+
+func (h *CustomerHandler) ConfirmDocumentUpload(ctx context.Context, req *customerv1.ConfirmDocumentUploadRequest) (*customerv1.ConfirmDocumentUploadResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	documentID, err := uuid.Parse(req.GetDocumentId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid document_id")
+	}
+	document, err := h.customers.ConfirmDocumentUpload(ctx, c.UserID, documentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDocumentInfected) {
+			return nil, status.Error(codes.FailedPrecondition, "document failed the virus scan")
+		}
+		return nil, mapDocumentError(err)
+	}
+	return &customerv1.ConfirmDocumentUploadResponse{Document: documentToProto(document)}, nil
+}
+
+func (h *CustomerHandler) ListBusinessDocuments(ctx context.Context, req *customerv1.ListBusinessDocumentsRequest) (*customerv1.ListBusinessDocumentsResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	business, err := h.customers.GetBusiness(ctx, businessID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	if business.UserID != c.UserID && !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.NotFound, "business not found")
+	}
+	documents, err := h.customers.BusinessDocuments(ctx, businessID)
+	if err != nil {
+		return nil, mapDocumentError(err)
+	}
+	resp := &customerv1.ListBusinessDocumentsResponse{}
+	for _, d := range documents {
+		resp.Documents = append(resp.Documents, documentToProto(d))
+	}
+	return resp, nil
+}
+
+func documentToProto(d *domain.Document) *customerv1.BusinessDocument {
+	return &customerv1.BusinessDocument{
+		Id:          d.ID.String(),
+		BusinessId:  d.BusinessID.String(),
+		Kind:        string(d.Kind),
+		ContentType: d.ContentType,
+		SizeBytes:   d.SizeBytes,
+		ScanStatus:  string(d.ScanStatus),
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+	}
+}
+
+// mapDocumentError extends mapCustomerError with document-specific
+// sentinels.
+func mapDocumentError(err error) error {
+	if errors.Is(err, domain.ErrDocumentNotFound) {
+		return status.Error(codes.NotFound, "document not found")
+	}
+	if errors.Is(err, domain.ErrUploadMissing) {
+		return status.Error(codes.FailedPrecondition, "no object was uploaded")
+	}
+	if errors.Is(err, domain.ErrUploadMismatch) {
+		return status.Error(codes.FailedPrecondition, "uploaded object does not match the declared document")
+	}
+	return mapCustomerError(err)
+}
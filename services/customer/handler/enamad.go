@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+	"github.com/K-H-Tech/infera/services/customer/service"
+)
+
+func (h *CustomerHandler) VerifyEnamad(ctx context.Context, req *customerv1.VerifyEnamadRequest) (*customerv1.VerifyEnamadResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	if req.GetEnamadId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "enamad_id is required")
+	}
+	business, err := h.customers.VerifyEnamad(ctx, c.UserID, businessID, req.GetEnamadId())
+	if err != nil {
+		if errors.Is(err, service.ErrEnamadUnavailable) {
+			return nil, status.Error(codes.Unavailable, "enamad verification is not available")
+		}
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.VerifyEnamadResponse{Business: businessToProto(business)}, nil
+}
+
+func (h *CustomerHandler) ApproveOnlineBusiness(ctx context.Context, req *customerv1.ApproveOnlineBusinessRequest) (*customerv1.ApproveOnlineBusinessResponse, error) {
+	c, err := requireBackoffice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	business, err := h.customers.ApproveBusiness(ctx, c.UserID, businessID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEnamadNotVerified) {
+			return nil, status.Error(codes.FailedPrecondition, "business has no valid enamad verification")
+		}
+		if errors.Is(err, domain.ErrDocumentsRequired) {
+			return nil, status.Error(codes.FailedPrecondition, "business is missing required documents")
+		}
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.ApproveOnlineBusinessResponse{Business: businessToProto(business)}, nil
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+)
+
+func (h *CustomerHandler) GetOnlineBusiness(ctx context.Context, req *customerv1.GetOnlineBusinessRequest) (*customerv1.GetOnlineBusinessResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	business, err := h.customers.GetBusiness(ctx, businessID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	// Non-owners get NotFound rather than PermissionDenied so the check
+	// does not confirm the record exists.
+	if business.UserID != c.UserID && !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.NotFound, "business not found")
+	}
+	return &customerv1.GetOnlineBusinessResponse{Business: businessToProto(business)}, nil
+}
+
+func (h *CustomerHandler) GetCustomer(ctx context.Context, req *customerv1.GetCustomerRequest) (*customerv1.GetCustomerResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	customerID, err := uuid.Parse(req.GetCustomerId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid customer_id")
+	}
+	customer, err := h.customers.GetCustomer(ctx, customerID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	if customer.UserID != c.UserID && !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.NotFound, "customer not found")
+	}
+	return &customerv1.GetCustomerResponse{Customer: customerToProto(customer)}, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"context"
+
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+)
+
+func (h *CustomerHandler) ListGuilds(ctx context.Context, req *customerv1.ListGuildsRequest) (*customerv1.ListGuildsResponse, error) {
+	guilds, err := h.customers.ListGuilds(ctx, !req.GetIncludeInactive())
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	resp := &customerv1.ListGuildsResponse{}
+	for _, g := range guilds {
+		resp.Guilds = append(resp.Guilds, &customerv1.Guild{
+			Code:   g.Code,
+			Title:  g.Title,
+			Active: g.Active,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"context"
+
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+)
+
+func (h *CustomerHandler) ImportBusinesses(ctx context.Context, req *customerv1.ImportBusinessesRequest) (*customerv1.ImportBusinessesResponse, error) {
+	c, err := requireBackoffice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := h.customers.ImportBusinesses(ctx, req.GetFormat(), req.GetContent(), c.UserID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	resp := &customerv1.ImportBusinessesResponse{
+		TotalRows:    int32(report.Total),
+		ImportedRows: int32(report.Imported),
+		SkippedRows:  int32(report.Skipped),
+	}
+	for _, failure := range report.Failures {
+		resp.Failures = append(resp.Failures, &customerv1.ImportFailure{
+			Line:        int32(failure.Line),
+			ExternalRef: failure.Ref,
+			Reason:      failure.Reason,
+		})
+	}
+	return resp, nil
+}
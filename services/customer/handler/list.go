@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+	"github.com/K-H-Tech/infera/services/customer/service"
+)
+
+// RoleBackoffice marks backoffice operators in forwarded claims.
+const RoleBackoffice = "backoffice"
+
+func (h *CustomerHandler) ListOnlineBusinesses(ctx context.Context, req *customerv1.ListOnlineBusinessesRequest) (*customerv1.ListOnlineBusinessesResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+
+	filter := domain.BusinessFilter{Status: domain.BusinessStatus(req.GetStatus())}
+	if req.GetUserId() != "" {
+		userID, err := uuid.Parse(req.GetUserId())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+		}
+		filter.UserID = userID
+	}
+	// Non-backoffice callers only ever see their own businesses,
+	// regardless of the filter they ask for.
+	if !c.HasRole(RoleBackoffice) {
+		filter.UserID = c.UserID
+	}
+	if req.GetCreatedFrom() != nil {
+		filter.CreatedFrom = req.GetCreatedFrom().AsTime()
+	}
+	if req.GetCreatedTo() != nil {
+		filter.CreatedTo = req.GetCreatedTo().AsTime()
+	}
+
+	businesses, nextToken, err := h.customers.ListBusinesses(ctx,
+		filter, int(req.GetPageSize()), req.GetPageToken(), req.GetAscending())
+	if err != nil {
+		if errors.Is(err, service.ErrBadPageToken) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, mapCustomerError(err)
+	}
+
+	resp := &customerv1.ListOnlineBusinessesResponse{NextPageToken: nextToken}
+	for _, b := range businesses {
+		resp.Businesses = append(resp.Businesses, businessToProto(b))
+	}
+	return resp, nil
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+)
+
+func (h *CustomerHandler) GetOnboardingProgress(ctx context.Context, req *customerv1.GetOnboardingProgressRequest) (*customerv1.GetOnboardingProgressResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	business, err := h.customers.GetBusiness(ctx, businessID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	if business.UserID != c.UserID && !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.NotFound, "business not found")
+	}
+
+	steps, err := h.customers.OnboardingProgress(ctx, business)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	resp := &customerv1.GetOnboardingProgressResponse{}
+	for _, step := range steps {
+		resp.Steps = append(resp.Steps, &customerv1.OnboardingStep{
+			Key:      step.Key,
+			Complete: step.Complete,
+		})
+		if step.Complete {
+			resp.CompletedSteps++
+		}
+	}
+	resp.TotalSteps = int32(len(steps))
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+)
+
+func (h *CustomerHandler) GetBusinessReport(ctx context.Context, req *customerv1.GetBusinessReportRequest) (*customerv1.GetBusinessReportResponse, error) {
+	if _, err := requireBackoffice(ctx); err != nil {
+		return nil, err
+	}
+	report, err := h.customers.BusinessReport(ctx, req.GetFrom().AsTime(), req.GetTo().AsTime())
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+
+	resp := &customerv1.GetBusinessReportResponse{
+		From: timestamppb.New(report.From),
+		To:   timestamppb.New(report.To),
+		ApprovalLatency: &customerv1.ApprovalLatency{
+			P50Seconds: report.Latency.P50.Seconds(),
+			P90Seconds: report.Latency.P90.Seconds(),
+			P99Seconds: report.Latency.P99.Seconds(),
+		},
+	}
+	for _, c := range report.ByStatus {
+		resp.ByStatus = append(resp.ByStatus, &customerv1.StatusCount{
+			Status: string(c.Status), Count: c.Count,
+		})
+	}
+	for _, c := range report.ByGuild {
+		resp.ByGuild = append(resp.ByGuild, &customerv1.GuildCount{
+			Guild: c.Guild, Count: c.Count,
+		})
+	}
+	for _, c := range report.CreatedByDay {
+		resp.CreatedByDay = append(resp.CreatedByDay, &customerv1.DailyCount{
+			Day: timestamppb.New(c.Day), Count: c.Count,
+		})
+	}
+	return resp, nil
+}
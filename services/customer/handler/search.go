@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+)
+
+// requireBackoffice rejects callers without the backoffice role.
+func requireBackoffice(ctx context.Context) (*claims.Claims, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.PermissionDenied, "requires the backoffice role")
+	}
+	return c, nil
+}
+
+func (h *CustomerHandler) SearchOnlineBusinesses(ctx context.Context, req *customerv1.SearchOnlineBusinessesRequest) (*customerv1.SearchOnlineBusinessesResponse, error) {
+	if _, err := requireBackoffice(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetQuery() == "" && req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "query or user_id is required")
+	}
+	userID, err := parseOptionalUUID(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	businesses, err := h.customers.SearchBusinesses(ctx,
+		req.GetQuery(), userID, int(req.GetPageSize()), int(req.GetPage()))
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	resp := &customerv1.SearchOnlineBusinessesResponse{}
+	for _, b := range businesses {
+		resp.Businesses = append(resp.Businesses, businessToProto(b))
+	}
+	return resp, nil
+}
+
+func (h *CustomerHandler) SearchCustomers(ctx context.Context, req *customerv1.SearchCustomersRequest) (*customerv1.SearchCustomersResponse, error) {
+	if _, err := requireBackoffice(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetNationalId() == "" && req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "national_id or user_id is required")
+	}
+	userID, err := parseOptionalUUID(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	customers, err := h.customers.SearchCustomers(ctx,
+		req.GetNationalId(), userID, int(req.GetPageSize()), int(req.GetPage()))
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	resp := &customerv1.SearchCustomersResponse{}
+	for _, c := range customers {
+		resp.Customers = append(resp.Customers, customerToProto(c))
+	}
+	return resp, nil
+}
+
+// parseOptionalUUID treats "" as uuid.Nil instead of an error.
+func parseOptionalUUID(s string) (uuid.UUID, error) {
+	if s == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(s)
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+	"github.com/K-H-Tech/infera/services/customer/service"
+)
+
+func (h *CustomerHandler) InitiateBusinessTransfer(ctx context.Context, req *customerv1.InitiateBusinessTransferRequest) (*customerv1.InitiateBusinessTransferResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	businessID, err := uuid.Parse(req.GetBusinessId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid business_id")
+	}
+	toUserID, err := uuid.Parse(req.GetToUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to_user_id")
+	}
+	transfer, err := h.customers.InitiateTransfer(ctx, c.UserID, businessID, toUserID)
+	if err != nil {
+		return nil, mapTransferError(err)
+	}
+	return &customerv1.InitiateBusinessTransferResponse{Transfer: transferToProto(transfer)}, nil
+}
+
+func (h *CustomerHandler) RequestBusinessTransferOTP(ctx context.Context, req *customerv1.RequestBusinessTransferOTPRequest) (*customerv1.RequestBusinessTransferOTPResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	transferID, err := uuid.Parse(req.GetTransferId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transfer_id")
+	}
+	if err := h.customers.RequestTransferOTP(ctx, c.UserID, c.Mobile, transferID); err != nil {
+		if errors.Is(err, service.ErrOTPSenderUnavailable) {
+			return nil, status.Error(codes.Unavailable, "transfer confirmation is not available")
+		}
+		return nil, mapTransferError(err)
+	}
+	return &customerv1.RequestBusinessTransferOTPResponse{}, nil
+}
+
+func (h *CustomerHandler) ConfirmBusinessTransfer(ctx context.Context, req *customerv1.ConfirmBusinessTransferRequest) (*customerv1.ConfirmBusinessTransferResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	transferID, err := uuid.Parse(req.GetTransferId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transfer_id")
+	}
+	transfer, err := h.customers.ConfirmTransfer(ctx, c.UserID, transferID, req.GetCode())
+	if err != nil {
+		return nil, mapTransferError(err)
+	}
+	return &customerv1.ConfirmBusinessTransferResponse{Transfer: transferToProto(transfer)}, nil
+}
+
+func (h *CustomerHandler) CancelBusinessTransfer(ctx context.Context, req *customerv1.CancelBusinessTransferRequest) (*customerv1.CancelBusinessTransferResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	transferID, err := uuid.Parse(req.GetTransferId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid transfer_id")
+	}
+	if err := h.customers.CancelTransfer(ctx, c.UserID, transferID); err != nil {
+		return nil, mapTransferError(err)
+	}
+	return &customerv1.CancelBusinessTransferResponse{}, nil
+}
+
+func (h *CustomerHandler) ReassignBusinessOwner(ctx context.Context, req *customerv1.ReassignBusinessOwnerRequest) (*customerv1.ReassignBusinessOwnerResponse, error) {
+	c, err := requireBackoffice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fromUserID, err := uuid.Parse(req.GetFromUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid from_user_id")
+	}
+	toUserID, err := uuid.Parse(req.GetToUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid to_user_id")
+	}
+	moved, err := h.customers.ReassignBusinesses(ctx, c.UserID, fromUserID, toUserID)
+	if err != nil {
+		return nil, mapCustomerError(err)
+	}
+	return &customerv1.ReassignBusinessOwnerResponse{Moved: moved}, nil
+}
+
+func transferToProto(t *domain.BusinessTransfer) *customerv1.BusinessTransfer {
+	return &customerv1.BusinessTransfer{
+		Id:         t.ID.String(),
+		BusinessId: t.BusinessID.String(),
+		FromUserId: t.FromUserID.String(),
+		ToUserId:   t.ToUserID.String(),
+		Status:     string(t.Status),
+		ExpiresAt:  timestamppb.New(t.ExpiresAt),
+	}
+}
+
+func mapTransferError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrTransferNotFound):
+		return status.Error(codes.NotFound, "transfer not found")
+	case errors.Is(err, domain.ErrTransferNotPending):
+		return status.Error(codes.FailedPrecondition, "transfer is not pending")
+	case errors.Is(err, domain.ErrTransferExists):
+		return status.Error(codes.AlreadyExists, "business already has a pending transfer")
+	case errors.Is(err, domain.ErrTransferOTPInvalid):
+		return status.Error(codes.InvalidArgument, "invalid or expired code")
+	default:
+		return mapCustomerError(err)
+	}
+}
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS customers (
+    id          UUID PRIMARY KEY,
+    user_id     UUID        NOT NULL,
+    type        TEXT        NOT NULL DEFAULT 'individual',
+    national_id TEXT        NOT NULL DEFAULT '',
+    version     BIGINT      NOT NULL DEFAULT 1,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customers_user_id ON customers (user_id);
+
+CREATE TABLE IF NOT EXISTS online_businesses (
+    id           UUID PRIMARY KEY,
+    user_id      UUID        NOT NULL,
+    url          TEXT        NOT NULL,
+    website_name TEXT        NOT NULL DEFAULT '',
+    status       TEXT        NOT NULL DEFAULT 'pending',
+    guild        TEXT        NOT NULL DEFAULT '',
+    license_id   TEXT        NOT NULL DEFAULT '',
+    postal_code  TEXT        NOT NULL DEFAULT '',
+    phones       TEXT[]      NOT NULL DEFAULT '{}',
+    tax_id       TEXT        NOT NULL DEFAULT '',
+    iban         TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_online_businesses_url ON online_businesses (url);
+CREATE INDEX IF NOT EXISTS idx_online_businesses_user_id ON online_businesses (user_id);
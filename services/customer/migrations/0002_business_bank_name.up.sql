@@ -0,0 +1,8 @@
+ALTER TABLE online_businesses
+    ADD COLUMN IF NOT EXISTS bank_name TEXT NOT NULL DEFAULT '';
+
+-- Settlement IBANs must be unique across merchants; empty values (no
+-- financial data yet) are exempt.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_online_businesses_iban
+    ON online_businesses (iban)
+    WHERE iban <> '';
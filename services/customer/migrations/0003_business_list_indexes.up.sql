@@ -0,0 +1,7 @@
+-- Keyset pagination orders by (created_at, id); the status prefix serves
+-- the most common backoffice filter.
+CREATE INDEX IF NOT EXISTS idx_online_businesses_keyset
+    ON online_businesses (created_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_online_businesses_status_created
+    ON online_businesses (status, created_at);
@@ -0,0 +1,15 @@
+ALTER TABLE customers
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS created_by UUID,
+    ADD COLUMN IF NOT EXISTS updated_by UUID;
+
+ALTER TABLE online_businesses
+    ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS created_by UUID,
+    ADD COLUMN IF NOT EXISTS updated_by UUID;
+
+-- A soft-deleted business releases its IBAN for reuse.
+DROP INDEX IF EXISTS idx_online_businesses_iban;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_online_businesses_iban
+    ON online_businesses (iban)
+    WHERE iban <> '' AND deleted_at IS NULL;
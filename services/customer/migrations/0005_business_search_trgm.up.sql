@@ -0,0 +1,14 @@
+-- Trigram indexes back the backoffice fuzzy search on URL and website
+-- name (ILIKE + similarity ranking).
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_online_businesses_url_trgm
+    ON online_businesses USING GIN (url gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS idx_online_businesses_website_name_trgm
+    ON online_businesses USING GIN (website_name gin_trgm_ops);
+
+-- Exact lookups by national ID for support staff.
+CREATE INDEX IF NOT EXISTS idx_customers_national_id
+    ON customers (national_id)
+    WHERE national_id <> '';
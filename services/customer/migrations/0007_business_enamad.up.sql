@@ -0,0 +1,4 @@
+ALTER TABLE online_businesses
+    ADD COLUMN IF NOT EXISTS enamad_id TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS enamad_status TEXT NOT NULL DEFAULT 'unverified',
+    ADD COLUMN IF NOT EXISTS enamad_expires_at TIMESTAMPTZ;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS guilds (
+    code    TEXT PRIMARY KEY,
+    title   TEXT NOT NULL,
+    active  BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- Seed reference data. New guilds are added by later migrations, never
+-- edited by application code.
+INSERT INTO guilds (code, title) VALUES
+    ('retail',         'خرده‌فروشی کالا'),
+    ('digital_goods',  'محصولات دیجیتال'),
+    ('food',           'رستوران و غذا'),
+    ('travel',         'گردشگری و سفر'),
+    ('education',      'آموزش'),
+    ('health',         'سلامت و درمان'),
+    ('services',       'خدمات'),
+    ('charity',        'خیریه'),
+    ('content',        'محتوا و رسانه'),
+    ('insurance',      'بیمه')
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS business_documents (
+    id            UUID PRIMARY KEY,
+    business_id   UUID NOT NULL REFERENCES online_businesses(id),
+    kind          TEXT NOT NULL,
+    object_key    TEXT NOT NULL,
+    content_type  TEXT NOT NULL,
+    size_bytes    BIGINT NOT NULL,
+    scan_status   TEXT NOT NULL DEFAULT 'pending',
+    uploaded_by   UUID NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_business_documents_business
+    ON business_documents (business_id, kind);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS business_transfers (
+    id              UUID PRIMARY KEY,
+    business_id     UUID NOT NULL REFERENCES online_businesses(id),
+    from_user_id    UUID NOT NULL,
+    to_user_id      UUID NOT NULL,
+    status          TEXT NOT NULL DEFAULT 'pending',
+    otp_hash        TEXT NOT NULL DEFAULT '',
+    otp_expires_at  TIMESTAMPTZ,
+    attempts        INT NOT NULL DEFAULT 0,
+    expires_at      TIMESTAMPTZ NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- One pending transfer per business at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_business_transfers_pending
+    ON business_transfers (business_id)
+    WHERE status = 'pending';
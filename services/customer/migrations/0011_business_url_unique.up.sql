@@ -0,0 +1,4 @@
+-- A URL identifies one live business; soft-deleted rows release theirs.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_online_businesses_url
+    ON online_businesses (lower(url))
+    WHERE deleted_at IS NULL;
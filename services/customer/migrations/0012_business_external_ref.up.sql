@@ -0,0 +1,7 @@
+ALTER TABLE online_businesses
+    ADD COLUMN IF NOT EXISTS external_ref TEXT NOT NULL DEFAULT '';
+
+-- Bulk-import idempotency: one row per source-system reference.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_online_businesses_external_ref
+    ON online_businesses (external_ref)
+    WHERE external_ref <> '';
@@ -0,0 +1,15 @@
+-- approved_at feeds the backoffice approval-latency report; it is
+-- stamped once, on the first transition to 'approved'.
+ALTER TABLE online_businesses
+    ADD COLUMN IF NOT EXISTS approved_at TIMESTAMPTZ;
+
+-- Rows approved before this column existed get their last write time as
+-- a best-effort backfill.
+UPDATE online_businesses
+SET approved_at = updated_at
+WHERE status = 'approved' AND approved_at IS NULL;
+
+-- Serves the date-bucketed throughput report.
+CREATE INDEX IF NOT EXISTS idx_online_businesses_created_at
+    ON online_businesses (created_at)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,27 @@
+-- Starter sqlc queries for the customer service. Repositories adopt the
+-- generated equivalents incrementally, replacing hand-scanned columns;
+-- new queries should start here rather than inline in Go.
+
+-- name: GetOnlineBusiness :one
+SELECT id, user_id, url, website_name, status, guild, license_id,
+       postal_code, phones, tax_id, iban, created_at, updated_at
+FROM online_businesses
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: ListOnlineBusinessesByUser :many
+SELECT id, user_id, url, website_name, status, guild, license_id,
+       postal_code, phones, tax_id, iban, created_at, updated_at
+FROM online_businesses
+WHERE user_id = $1 AND deleted_at IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: GetCustomerByUserID :one
+SELECT id, user_id, type, national_id, version, created_at, updated_at
+FROM customers
+WHERE user_id = $1;
+
+-- name: CountOnlineBusinessesByStatus :many
+SELECT status, count(*) AS total
+FROM online_businesses
+WHERE deleted_at IS NULL
+GROUP BY status;
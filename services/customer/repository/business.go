@@ -0,0 +1,315 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/crypto"
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// OnlineBusinessRepository is the Postgres implementation of
+// domain.OnlineBusinessRepository.
+type OnlineBusinessRepository struct {
+	pool   *pgxpool.Pool
+	cipher *crypto.FieldCipher
+}
+
+// NewOnlineBusinessRepository builds the repository over the given pool.
+func NewOnlineBusinessRepository(pool *pgxpool.Pool) *OnlineBusinessRepository {
+	return &OnlineBusinessRepository{pool: pool}
+}
+
+// WithCipher makes the repository encrypt the IBAN at rest.
+// Deterministic mode keeps the unique index and equality lookups
+// working; rows written before encryption decrypt as plaintext
+// passthrough.
+func (r *OnlineBusinessRepository) WithCipher(cipher *crypto.FieldCipher) *OnlineBusinessRepository {
+	r.cipher = cipher
+	return r
+}
+
+// q resolves the querier for one call: the pool, or the transaction a
+// db.TxManager bound to the context.
+func (r *OnlineBusinessRepository) q(ctx context.Context) db.Querier {
+	return db.QuerierFrom(ctx, r.pool)
+}
+
+// encPII encrypts a PII value for storage or lookup; empty strings and
+// cipherless repositories pass through.
+func (r *OnlineBusinessRepository) encPII(value string) string {
+	if r.cipher == nil || value == "" {
+		return value
+	}
+	return r.cipher.EncryptDeterministic(value)
+}
+
+const businessColumns = "id, user_id, url, website_name, status, guild, license_id, postal_code, phones, tax_id, iban, bank_name, external_ref, enamad_id, enamad_status, enamad_expires_at, created_at, updated_at, created_by, updated_by"
+
+func (r *OnlineBusinessRepository) Create(ctx context.Context, b *domain.OnlineBusiness) error {
+	_, err := r.q(ctx).Exec(ctx, `
+		INSERT INTO online_businesses
+			(id, user_id, url, website_name, status, guild, license_id, postal_code, phones, tax_id, iban, bank_name, external_ref, enamad_status, created_at, updated_at, created_by, updated_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, now(), now(), $15, $15)`,
+		b.ID, b.UserID, b.URL, b.WebsiteName, b.Status, b.Guild, b.LicenseID,
+		b.PostalCode, b.Phones, b.TaxID, r.encPII(b.IBAN), b.BankName, b.ExternalRef, b.EnamadStatus, b.CreatedBy)
+	if db.IsUniqueViolation(err) {
+		return businessConstraintError(err)
+	}
+	if err != nil {
+		return fmt.Errorf("insert online_businesses: %w", err)
+	}
+	return nil
+}
+
+// businessConstraintError maps a unique violation to the domain error
+// for the colliding field.
+func businessConstraintError(err error) error {
+	switch db.ConstraintName(err) {
+	case "idx_online_businesses_url":
+		return domain.ErrDuplicateURL
+	case "idx_online_businesses_iban":
+		return domain.ErrDuplicateIBAN
+	case "idx_online_businesses_external_ref":
+		return domain.ErrDuplicateExternalRef
+	default:
+		return fmt.Errorf("online_businesses constraint %s: %w", db.ConstraintName(err), err)
+	}
+}
+
+func (r *OnlineBusinessRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.OnlineBusiness, error) {
+	row := r.q(ctx).QueryRow(ctx,
+		"SELECT "+businessColumns+" FROM online_businesses WHERE id = $1 AND deleted_at IS NULL", id)
+	return r.scanBusiness(row)
+}
+
+func (r *OnlineBusinessRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*domain.OnlineBusiness, error) {
+	rows, err := r.q(ctx).Query(ctx,
+		"SELECT "+businessColumns+" FROM online_businesses WHERE user_id = $1 AND deleted_at IS NULL ORDER BY created_at", userID)
+	if err != nil {
+		return nil, fmt.Errorf("select online_businesses: %w", err)
+	}
+	defer rows.Close()
+
+	var businesses []*domain.OnlineBusiness
+	for rows.Next() {
+		b, err := r.scanBusiness(rows)
+		if err != nil {
+			return nil, err
+		}
+		businesses = append(businesses, b)
+	}
+	return businesses, rows.Err()
+}
+
+func (r *OnlineBusinessRepository) UpdateInfo(ctx context.Context, b *domain.OnlineBusiness) (*domain.OnlineBusiness, error) {
+	row := r.q(ctx).QueryRow(ctx, `
+		UPDATE online_businesses
+		SET guild = $2, license_id = $3, postal_code = $4, phones = $5, updated_by = $6, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+businessColumns,
+		b.ID, b.Guild, b.LicenseID, b.PostalCode, b.Phones, b.UpdatedBy)
+	return r.scanBusiness(row)
+}
+
+func (r *OnlineBusinessRepository) UpdateFinancial(ctx context.Context, b *domain.OnlineBusiness) (*domain.OnlineBusiness, error) {
+	row := r.q(ctx).QueryRow(ctx, `
+		UPDATE online_businesses
+		SET tax_id = $2, iban = $3, bank_name = $4, updated_by = $5, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+businessColumns,
+		b.ID, b.TaxID, r.encPII(b.IBAN), b.BankName, b.UpdatedBy)
+	return r.scanBusiness(row)
+}
+
+func (r *OnlineBusinessRepository) ExternalRefExists(ctx context.Context, ref string) (bool, error) {
+	var exists bool
+	err := r.q(ctx).QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM online_businesses WHERE external_ref = $1)", ref).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("select online_businesses: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *OnlineBusinessRepository) IBANInUse(ctx context.Context, iban string, excludeID uuid.UUID) (bool, error) {
+	var exists bool
+	err := r.q(ctx).QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM online_businesses WHERE iban = $1 AND id <> $2 AND deleted_at IS NULL)",
+		r.encPII(iban), excludeID).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("select online_businesses: %w", err)
+	}
+	return exists, nil
+}
+
+// List pages businesses with keyset pagination on (created_at, id),
+// which the idx_online_businesses_keyset index serves directly.
+func (r *OnlineBusinessRepository) List(ctx context.Context, filter domain.BusinessFilter, cursor *domain.BusinessCursor, limit int, ascending bool) ([]*domain.OnlineBusiness, error) {
+	where := "WHERE deleted_at IS NULL"
+	args := []any{}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.Status != "" {
+		where += " AND status = " + arg(string(filter.Status))
+	}
+	if filter.UserID != uuid.Nil {
+		where += " AND user_id = " + arg(filter.UserID)
+	}
+	if !filter.CreatedFrom.IsZero() {
+		where += " AND created_at >= " + arg(filter.CreatedFrom)
+	}
+	if !filter.CreatedTo.IsZero() {
+		where += " AND created_at < " + arg(filter.CreatedTo)
+	}
+
+	order := "ORDER BY created_at DESC, id DESC"
+	if ascending {
+		order = "ORDER BY created_at ASC, id ASC"
+	}
+	if cursor != nil {
+		cmp := "<"
+		if ascending {
+			cmp = ">"
+		}
+		where += fmt.Sprintf(" AND (created_at, id) %s (%s, %s)", cmp, arg(cursor.CreatedAt), arg(cursor.ID))
+	}
+
+	query := "SELECT " + businessColumns + " FROM online_businesses " + where + " " + order + " LIMIT " + arg(limit)
+	rows, err := r.q(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("select online_businesses: %w", err)
+	}
+	defer rows.Close()
+
+	var businesses []*domain.OnlineBusiness
+	for rows.Next() {
+		b, err := r.scanBusiness(rows)
+		if err != nil {
+			return nil, err
+		}
+		businesses = append(businesses, b)
+	}
+	return businesses, rows.Err()
+}
+
+func (r *OnlineBusinessRepository) Delete(ctx context.Context, id, deletedBy uuid.UUID) error {
+	tag, err := r.q(ctx).Exec(ctx, `
+		UPDATE online_businesses
+		SET deleted_at = now(), updated_by = $2, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL`,
+		id, deletedBy)
+	if err != nil {
+		return fmt.Errorf("update online_businesses: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrBusinessNotFound
+	}
+	return nil
+}
+
+func (r *OnlineBusinessRepository) Restore(ctx context.Context, id, restoredBy uuid.UUID) (*domain.OnlineBusiness, error) {
+	row := r.q(ctx).QueryRow(ctx, `
+		UPDATE online_businesses
+		SET deleted_at = NULL, updated_by = $2, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NOT NULL
+		RETURNING `+businessColumns,
+		id, restoredBy)
+	return r.scanBusiness(row)
+}
+
+// Search ranks fuzzy matches on URL and website name by trigram
+// similarity; the ILIKE predicates are served by the
+// idx_online_businesses_*_trgm GIN indexes.
+func (r *OnlineBusinessRepository) Search(ctx context.Context, query string, userID uuid.UUID, limit, offset int) ([]*domain.OnlineBusiness, error) {
+	where := "WHERE deleted_at IS NULL AND (url ILIKE '%' || $1 || '%' OR website_name ILIKE '%' || $1 || '%')"
+	args := []any{query}
+	if userID != uuid.Nil {
+		args = append(args, userID)
+		where += fmt.Sprintf(" AND user_id = $%d", len(args))
+	}
+	args = append(args, limit, offset)
+	rows, err := r.q(ctx).Query(ctx,
+		"SELECT "+businessColumns+" FROM online_businesses "+where+
+			fmt.Sprintf(" ORDER BY GREATEST(similarity(url, $1), similarity(website_name, $1)) DESC, id LIMIT $%d OFFSET $%d", len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, fmt.Errorf("select online_businesses: %w", err)
+	}
+	defer rows.Close()
+
+	var businesses []*domain.OnlineBusiness
+	for rows.Next() {
+		b, err := r.scanBusiness(rows)
+		if err != nil {
+			return nil, err
+		}
+		businesses = append(businesses, b)
+	}
+	return businesses, rows.Err()
+}
+
+func (r *OnlineBusinessRepository) UpdateEnamad(ctx context.Context, id uuid.UUID, enamadID string, status domain.EnamadStatus, expiresAt *time.Time, updatedBy uuid.UUID) (*domain.OnlineBusiness, error) {
+	row := r.q(ctx).QueryRow(ctx, `
+		UPDATE online_businesses
+		SET enamad_id = $2, enamad_status = $3, enamad_expires_at = $4, updated_by = $5, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+businessColumns,
+		id, enamadID, status, expiresAt, updatedBy)
+	return r.scanBusiness(row)
+}
+
+func (r *OnlineBusinessRepository) SetStatus(ctx context.Context, id uuid.UUID, status domain.BusinessStatus, updatedBy uuid.UUID) (*domain.OnlineBusiness, error) {
+	row := r.q(ctx).QueryRow(ctx, `
+		UPDATE online_businesses
+		SET status = $2, updated_by = $3, updated_at = now(),
+			approved_at = CASE WHEN $2 = 'approved' THEN coalesce(approved_at, now()) ELSE approved_at END
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+businessColumns,
+		id, status, updatedBy)
+	return r.scanBusiness(row)
+}
+
+func (r *OnlineBusinessRepository) ReassignOwner(ctx context.Context, fromUserID, toUserID, updatedBy uuid.UUID) (int64, error) {
+	tag, err := r.q(ctx).Exec(ctx, `
+		UPDATE online_businesses
+		SET user_id = $2, updated_by = $3, updated_at = now()
+		WHERE user_id = $1 AND deleted_at IS NULL`,
+		fromUserID, toUserID, updatedBy)
+	if err != nil {
+		return 0, fmt.Errorf("update online_businesses: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *OnlineBusinessRepository) scanBusiness(row pgx.Row) (*domain.OnlineBusiness, error) {
+	var b domain.OnlineBusiness
+	err := row.Scan(&b.ID, &b.UserID, &b.URL, &b.WebsiteName, &b.Status, &b.Guild,
+		&b.LicenseID, &b.PostalCode, &b.Phones, &b.TaxID, &b.IBAN, &b.BankName,
+		&b.ExternalRef, &b.EnamadID, &b.EnamadStatus, &b.EnamadExpiresAt,
+		&b.CreatedAt, &b.UpdatedAt, &b.CreatedBy, &b.UpdatedBy)
+	if db.IsNotFound(err) {
+		return nil, domain.ErrBusinessNotFound
+	}
+	// RETURNING updates can also trip unique constraints (e.g. IBAN).
+	if db.IsUniqueViolation(err) {
+		return nil, businessConstraintError(err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select online_businesses: %w", err)
+	}
+	if r.cipher != nil {
+		if b.IBAN, err = r.cipher.Decrypt(b.IBAN); err != nil {
+			return nil, fmt.Errorf("decrypt iban: %w", err)
+		}
+	}
+	return &b, nil
+}
@@ -0,0 +1,179 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/crypto"
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/pkg/db/dbtest"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+	"github.com/K-H-Tech/infera/services/customer/migrations"
+	"github.com/K-H-Tech/infera/services/customer/repository"
+)
+
+func newBusiness(userID uuid.UUID, url string) *domain.OnlineBusiness {
+	return &domain.OnlineBusiness{
+		ID:           uuid.New(),
+		UserID:       userID,
+		URL:          url,
+		WebsiteName:  "Test Shop",
+		Status:       domain.BusinessPending,
+		Phones:       []string{"02100000000"},
+		EnamadStatus: domain.EnamadUnverified,
+	}
+}
+
+func TestOnlineBusinessRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("repository tests need Docker")
+	}
+	pool := dbtest.StartPostgres(t, migrations.FS)
+	repo := repository.NewOnlineBusinessRepository(pool)
+
+	// Each subtest runs inside its own rolled-back transaction, so they
+	// share the container without seeing each other's rows.
+	t.Run("create and get round-trip", func(t *testing.T) {
+		ctx := dbtest.Isolate(t, pool)
+		b := newBusiness(uuid.New(), "https://shop.example.ir")
+		if err := repo.Create(ctx, b); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		got, err := repo.GetByID(ctx, b.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.URL != b.URL || got.Status != domain.BusinessPending || len(got.Phones) != 1 {
+			t.Fatalf("GetByID returned %+v", got)
+		}
+	})
+
+	t.Run("duplicate URL maps to ErrDuplicateURL", func(t *testing.T) {
+		ctx := dbtest.Isolate(t, pool)
+		if err := repo.Create(ctx, newBusiness(uuid.New(), "https://dup.example.ir")); err != nil {
+			t.Fatalf("Create first: %v", err)
+		}
+		err := repo.Create(ctx, newBusiness(uuid.New(), "https://dup.example.ir"))
+		if !errors.Is(err, domain.ErrDuplicateURL) {
+			t.Fatalf("Create duplicate: got %v, want ErrDuplicateURL", err)
+		}
+	})
+
+	t.Run("soft delete hides and restore brings back", func(t *testing.T) {
+		ctx := dbtest.Isolate(t, pool)
+		b := newBusiness(uuid.New(), "https://gone.example.ir")
+		actor := uuid.New()
+		if err := repo.Create(ctx, b); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if err := repo.Delete(ctx, b.ID, actor); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, b.ID); !errors.Is(err, domain.ErrBusinessNotFound) {
+			t.Fatalf("GetByID after delete: got %v, want ErrBusinessNotFound", err)
+		}
+		restored, err := repo.Restore(ctx, b.ID, actor)
+		if err != nil {
+			t.Fatalf("Restore: %v", err)
+		}
+		if restored.ID != b.ID {
+			t.Fatalf("Restore returned %+v", restored)
+		}
+	})
+
+	t.Run("keyset list pages without overlap", func(t *testing.T) {
+		ctx := dbtest.Isolate(t, pool)
+		userID := uuid.New()
+		for i := 0; i < 5; i++ {
+			b := newBusiness(userID, "https://page.example.ir/"+uuid.NewString())
+			if err := repo.Create(ctx, b); err != nil {
+				t.Fatalf("Create %d: %v", i, err)
+			}
+		}
+		filter := domain.BusinessFilter{UserID: userID}
+		first, err := repo.List(ctx, filter, nil, 3, false)
+		if err != nil {
+			t.Fatalf("List first page: %v", err)
+		}
+		if len(first) != 3 {
+			t.Fatalf("first page has %d rows, want 3", len(first))
+		}
+		last := first[len(first)-1]
+		second, err := repo.List(ctx, filter,
+			&domain.BusinessCursor{CreatedAt: last.CreatedAt, ID: last.ID}, 3, false)
+		if err != nil {
+			t.Fatalf("List second page: %v", err)
+		}
+		if len(second) != 2 {
+			t.Fatalf("second page has %d rows, want 2", len(second))
+		}
+		seen := map[uuid.UUID]bool{}
+		for _, b := range append(first, second...) {
+			if seen[b.ID] {
+				t.Fatalf("business %s appeared on both pages", b.ID)
+			}
+			seen[b.ID] = true
+		}
+	})
+
+	t.Run("encrypted IBAN stays unique and checkable", func(t *testing.T) {
+		ctx := dbtest.Isolate(t, pool)
+		enc := repository.NewOnlineBusinessRepository(pool).WithCipher(testCipher(t))
+
+		b := newBusiness(uuid.New(), "https://iban.example.ir")
+		b.IBAN = "IR000000000000000000000001"
+		if err := enc.Create(ctx, b); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		// At rest the column must hold ciphertext, not the IBAN.
+		if stored := rawColumn(t, ctx, pool, b.ID, "iban"); !strings.HasPrefix(stored, "enc:v1:") {
+			t.Fatalf("iban stored in the clear: %q", stored)
+		}
+
+		got, err := enc.GetByID(ctx, b.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.IBAN != b.IBAN {
+			t.Fatalf("decrypt round-trip returned %q", got.IBAN)
+		}
+
+		inUse, err := enc.IBANInUse(ctx, b.IBAN, uuid.New())
+		if err != nil {
+			t.Fatalf("IBANInUse: %v", err)
+		}
+		if !inUse {
+			t.Fatal("IBANInUse missed the encrypted row")
+		}
+	})
+}
+
+func testCipher(t *testing.T) *crypto.FieldCipher {
+	t.Helper()
+	cipher, err := crypto.NewFieldCipher("k1", map[string][]byte{
+		"k1": []byte("0123456789abcdef0123456789abcdef"),
+	})
+	if err != nil {
+		t.Fatalf("NewFieldCipher: %v", err)
+	}
+	return cipher
+}
+
+// rawColumn reads a column straight off the row, bypassing the
+// repository's decryption.
+func rawColumn(t *testing.T, ctx context.Context, pool *pgxpool.Pool, id uuid.UUID, column string) string {
+	t.Helper()
+	var value string
+	err := db.QuerierFrom(ctx, pool).QueryRow(ctx,
+		"SELECT "+column+" FROM online_businesses WHERE id = $1", id).Scan(&value)
+	if err != nil {
+		t.Fatalf("select raw %s: %v", column, err)
+	}
+	return value
+}
@@ -0,0 +1,157 @@
+// Package repository contains the customer service's Postgres
+// repositories.
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/crypto"
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// CustomerRepository is the Postgres implementation of
+// domain.CustomerRepository.
+type CustomerRepository struct {
+	pool   *pgxpool.Pool
+	cipher *crypto.FieldCipher
+}
+
+// NewCustomerRepository builds the repository over the given pool.
+func NewCustomerRepository(pool *pgxpool.Pool) *CustomerRepository {
+	return &CustomerRepository{pool: pool}
+}
+
+// WithCipher makes the repository encrypt the national ID at rest.
+// Deterministic mode keeps equality lookups working; rows written
+// before encryption decrypt as plaintext passthrough.
+func (r *CustomerRepository) WithCipher(cipher *crypto.FieldCipher) *CustomerRepository {
+	r.cipher = cipher
+	return r
+}
+
+// q resolves the querier for one call: the pool, or the transaction a
+// db.TxManager bound to the context.
+func (r *CustomerRepository) q(ctx context.Context) db.Querier {
+	return db.QuerierFrom(ctx, r.pool)
+}
+
+// encPII encrypts a PII value for storage or lookup; empty strings and
+// cipherless repositories pass through.
+func (r *CustomerRepository) encPII(value string) string {
+	if r.cipher == nil || value == "" {
+		return value
+	}
+	return r.cipher.EncryptDeterministic(value)
+}
+
+const customerColumns = "id, user_id, type, national_id, version, created_at, updated_at, created_by, updated_by"
+
+func (r *CustomerRepository) Create(ctx context.Context, c *domain.Customer) error {
+	_, err := r.q(ctx).Exec(ctx, `
+		INSERT INTO customers (id, user_id, type, national_id, version, created_at, updated_at, created_by, updated_by)
+		VALUES ($1, $2, $3, $4, 1, now(), now(), $5, $5)`,
+		c.ID, c.UserID, c.Type, r.encPII(c.NationalID), c.CreatedBy)
+	if err != nil {
+		return fmt.Errorf("insert customers: %w", err)
+	}
+	return nil
+}
+
+func (r *CustomerRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Customer, error) {
+	row := r.q(ctx).QueryRow(ctx,
+		"SELECT "+customerColumns+" FROM customers WHERE id = $1 AND deleted_at IS NULL", id)
+	return r.scanCustomer(row)
+}
+
+func (r *CustomerRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.Customer, error) {
+	row := r.q(ctx).QueryRow(ctx,
+		"SELECT "+customerColumns+" FROM customers WHERE user_id = $1 AND deleted_at IS NULL", userID)
+	return r.scanCustomer(row)
+}
+
+// UpdateNationalID sets the national ID with an optimistic version check:
+// no row matches when either the customer is gone or someone updated it
+// since the caller's read, and the two cases are told apart afterwards.
+func (r *CustomerRepository) UpdateNationalID(ctx context.Context, id uuid.UUID, nationalID string, version int64) (*domain.Customer, error) {
+	row := r.q(ctx).QueryRow(ctx, `
+		UPDATE customers
+		SET national_id = $2, version = version + 1, updated_at = now()
+		WHERE id = $1 AND version = $3 AND deleted_at IS NULL
+		RETURNING `+customerColumns, id, r.encPII(nationalID), version)
+	updated, err := r.scanCustomer(row)
+	if errors.Is(err, domain.ErrCustomerNotFound) {
+		if _, getErr := r.GetByID(ctx, id); getErr != nil {
+			return nil, getErr
+		}
+		return nil, domain.ErrConcurrentUpdate
+	}
+	return updated, err
+}
+
+func (r *CustomerRepository) SetType(ctx context.Context, id uuid.UUID, customerType domain.CustomerType) (*domain.Customer, error) {
+	row := r.q(ctx).QueryRow(ctx, `
+		UPDATE customers
+		SET type = $2,
+		    national_id = CASE WHEN type = $2 THEN national_id ELSE '' END,
+		    version = version + 1, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING `+customerColumns, id, customerType)
+	return r.scanCustomer(row)
+}
+
+func (r *CustomerRepository) Search(ctx context.Context, nationalID string, userID uuid.UUID, limit, offset int) ([]*domain.Customer, error) {
+	where := "WHERE deleted_at IS NULL"
+	args := []any{}
+	if nationalID != "" {
+		args = append(args, r.encPII(nationalID))
+		where += fmt.Sprintf(" AND national_id = $%d", len(args))
+	}
+	if userID != uuid.Nil {
+		args = append(args, userID)
+		where += fmt.Sprintf(" AND user_id = $%d", len(args))
+	}
+	args = append(args, limit, offset)
+	rows, err := r.q(ctx).Query(ctx,
+		"SELECT "+customerColumns+" FROM customers "+where+
+			fmt.Sprintf(" ORDER BY created_at DESC, id LIMIT $%d OFFSET $%d", len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, fmt.Errorf("select customers: %w", err)
+	}
+	defer rows.Close()
+
+	var customers []*domain.Customer
+	for rows.Next() {
+		c, err := r.scanCustomer(rows)
+		if err != nil {
+			return nil, err
+		}
+		customers = append(customers, c)
+	}
+	return customers, rows.Err()
+}
+
+func (r *CustomerRepository) scanCustomer(row pgx.Row) (*domain.Customer, error) {
+	var c domain.Customer
+	err := row.Scan(&c.ID, &c.UserID, &c.Type, &c.NationalID, &c.Version,
+		&c.CreatedAt, &c.UpdatedAt, &c.CreatedBy, &c.UpdatedBy)
+	if db.IsNotFound(err) {
+		return nil, domain.ErrCustomerNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select customers: %w", err)
+	}
+	if r.cipher != nil {
+		if c.NationalID, err = r.cipher.Decrypt(c.NationalID); err != nil {
+			return nil, fmt.Errorf("decrypt national_id: %w", err)
+		}
+	}
+	return &c, nil
+}
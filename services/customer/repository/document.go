@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// DocumentRepository is the Postgres implementation of
+// domain.DocumentRepository.
+type DocumentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDocumentRepository builds the repository over the given pool.
+func NewDocumentRepository(pool *pgxpool.Pool) *DocumentRepository {
+	return &DocumentRepository{pool: pool}
+}
+
+const documentColumns = "id, business_id, kind, object_key, content_type, size_bytes, scan_status, uploaded_by, created_at, updated_at"
+
+func (r *DocumentRepository) Create(ctx context.Context, d *domain.Document) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO business_documents
+			(id, business_id, kind, object_key, content_type, size_bytes, scan_status, uploaded_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, now(), now())`,
+		d.ID, d.BusinessID, d.Kind, d.ObjectKey, d.ContentType, d.SizeBytes, d.ScanStatus, d.UploadedBy)
+	if err != nil {
+		return fmt.Errorf("insert business_documents: %w", err)
+	}
+	return nil
+}
+
+func (r *DocumentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Document, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+documentColumns+" FROM business_documents WHERE id = $1", id)
+	return scanDocument(row)
+}
+
+func (r *DocumentRepository) ListByBusiness(ctx context.Context, businessID uuid.UUID) ([]*domain.Document, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+documentColumns+" FROM business_documents WHERE business_id = $1 ORDER BY created_at", businessID)
+	if err != nil {
+		return nil, fmt.Errorf("select business_documents: %w", err)
+	}
+	defer rows.Close()
+
+	var documents []*domain.Document
+	for rows.Next() {
+		d, err := scanDocument(rows)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, d)
+	}
+	return documents, rows.Err()
+}
+
+func (r *DocumentRepository) SetScanStatus(ctx context.Context, id uuid.UUID, status domain.ScanStatus) (*domain.Document, error) {
+	row := r.pool.QueryRow(ctx, `
+		UPDATE business_documents
+		SET scan_status = $2, updated_at = now()
+		WHERE id = $1
+		RETURNING `+documentColumns, id, status)
+	return scanDocument(row)
+}
+
+func (r *DocumentRepository) HasClean(ctx context.Context, businessID uuid.UUID, kind domain.DocumentKind) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM business_documents WHERE business_id = $1 AND kind = $2 AND scan_status = 'clean')",
+		businessID, kind).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("select business_documents: %w", err)
+	}
+	return exists, nil
+}
+
+func scanDocument(row pgx.Row) (*domain.Document, error) {
+	var d domain.Document
+	err := row.Scan(&d.ID, &d.BusinessID, &d.Kind, &d.ObjectKey, &d.ContentType,
+		&d.SizeBytes, &d.ScanStatus, &d.UploadedBy, &d.CreatedAt, &d.UpdatedAt)
+	if db.IsNotFound(err) {
+		return nil, domain.ErrDocumentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select business_documents: %w", err)
+	}
+	return &d, nil
+}
@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// GuildRepository is the Postgres implementation of
+// domain.GuildRepository.
+type GuildRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewGuildRepository builds the repository over the given pool.
+func NewGuildRepository(pool *pgxpool.Pool) *GuildRepository {
+	return &GuildRepository{pool: pool}
+}
+
+func (r *GuildRepository) List(ctx context.Context, activeOnly bool) ([]*domain.Guild, error) {
+	query := "SELECT code, title, active FROM guilds"
+	if activeOnly {
+		query += " WHERE active"
+	}
+	rows, err := r.pool.Query(ctx, query+" ORDER BY title")
+	if err != nil {
+		return nil, fmt.Errorf("select guilds: %w", err)
+	}
+	defer rows.Close()
+
+	var guilds []*domain.Guild
+	for rows.Next() {
+		var g domain.Guild
+		if err := rows.Scan(&g.Code, &g.Title, &g.Active); err != nil {
+			return nil, fmt.Errorf("scan guilds: %w", err)
+		}
+		guilds = append(guilds, &g)
+	}
+	return guilds, rows.Err()
+}
+
+func (r *GuildRepository) Exists(ctx context.Context, code string) (bool, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM guilds WHERE code = $1 AND active)", code).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("select guilds: %w", err)
+	}
+	return exists, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// ReportRepository is the Postgres implementation of
+// domain.ReportRepository.
+type ReportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewReportRepository builds the repository over the given pool.
+func NewReportRepository(pool *pgxpool.Pool) *ReportRepository {
+	return &ReportRepository{pool: pool}
+}
+
+func (r *ReportRepository) CountByStatus(ctx context.Context) ([]domain.StatusCount, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT status, count(*)
+		FROM online_businesses
+		WHERE deleted_at IS NULL
+		GROUP BY status
+		ORDER BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("count online_businesses by status: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []domain.StatusCount
+	for rows.Next() {
+		var c domain.StatusCount
+		if err := rows.Scan(&c.Status, &c.Count); err != nil {
+			return nil, fmt.Errorf("count online_businesses by status: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
+
+func (r *ReportRepository) CountByGuild(ctx context.Context) ([]domain.GuildCount, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT guild, count(*)
+		FROM online_businesses
+		WHERE deleted_at IS NULL AND guild <> ''
+		GROUP BY guild
+		ORDER BY count(*) DESC, guild`)
+	if err != nil {
+		return nil, fmt.Errorf("count online_businesses by guild: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []domain.GuildCount
+	for rows.Next() {
+		var c domain.GuildCount
+		if err := rows.Scan(&c.Guild, &c.Count); err != nil {
+			return nil, fmt.Errorf("count online_businesses by guild: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
+
+func (r *ReportRepository) CreatedByDay(ctx context.Context, from, to time.Time) ([]domain.DailyCount, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT date_trunc('day', created_at) AS day, count(*)
+		FROM online_businesses
+		WHERE deleted_at IS NULL AND created_at >= $1 AND created_at < $2
+		GROUP BY day
+		ORDER BY day`,
+		from, to)
+	if err != nil {
+		return nil, fmt.Errorf("count online_businesses by day: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []domain.DailyCount
+	for rows.Next() {
+		var c domain.DailyCount
+		if err := rows.Scan(&c.Day, &c.Count); err != nil {
+			return nil, fmt.Errorf("count online_businesses by day: %w", err)
+		}
+		counts = append(counts, c)
+	}
+	return counts, rows.Err()
+}
+
+// ApprovalLatency computes creation-to-approval percentiles in one pass;
+// percentile_cont over an empty window yields NULLs, reported as zeros.
+func (r *ReportRepository) ApprovalLatency(ctx context.Context, from, to time.Time) (domain.ApprovalLatency, error) {
+	var secs []*float64
+	err := r.pool.QueryRow(ctx, `
+		SELECT percentile_cont(ARRAY[0.5, 0.9, 0.99])
+			WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM approved_at - created_at))
+		FROM online_businesses
+		WHERE deleted_at IS NULL
+			AND approved_at IS NOT NULL
+			AND approved_at >= $1 AND approved_at < $2`,
+		from, to).Scan(&secs)
+	if err != nil {
+		return domain.ApprovalLatency{}, fmt.Errorf("approval latency percentiles: %w", err)
+	}
+	if len(secs) != 3 {
+		return domain.ApprovalLatency{}, nil
+	}
+	asDuration := func(s *float64) time.Duration {
+		if s == nil {
+			return 0
+		}
+		return time.Duration(*s * float64(time.Second))
+	}
+	return domain.ApprovalLatency{
+		P50: asDuration(secs[0]),
+		P90: asDuration(secs[1]),
+		P99: asDuration(secs[2]),
+	}, nil
+}
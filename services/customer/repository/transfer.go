@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// TransferRepository is the Postgres implementation of
+// domain.TransferRepository.
+type TransferRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTransferRepository builds the repository over the given pool.
+func NewTransferRepository(pool *pgxpool.Pool) *TransferRepository {
+	return &TransferRepository{pool: pool}
+}
+
+const transferColumns = "id, business_id, from_user_id, to_user_id, status, otp_hash, otp_expires_at, attempts, expires_at, created_at, updated_at"
+
+func (r *TransferRepository) Create(ctx context.Context, t *domain.BusinessTransfer) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO business_transfers
+			(id, business_id, from_user_id, to_user_id, status, otp_hash, attempts, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, '', 0, $6, now(), now())`,
+		t.ID, t.BusinessID, t.FromUserID, t.ToUserID, t.Status, t.ExpiresAt)
+	if db.IsUniqueViolation(err) {
+		return domain.ErrTransferExists
+	}
+	if err != nil {
+		return fmt.Errorf("insert business_transfers: %w", err)
+	}
+	return nil
+}
+
+func (r *TransferRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.BusinessTransfer, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+transferColumns+" FROM business_transfers WHERE id = $1", id)
+	return scanTransfer(row)
+}
+
+func (r *TransferRepository) SetOTP(ctx context.Context, id uuid.UUID, otpHash string, expiresAt time.Time) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE business_transfers
+		SET otp_hash = $2, otp_expires_at = $3, updated_at = now()
+		WHERE id = $1 AND status = 'pending'`,
+		id, otpHash, expiresAt)
+	if err != nil {
+		return fmt.Errorf("update business_transfers: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTransferNotPending
+	}
+	return nil
+}
+
+func (r *TransferRepository) RecordAttempt(ctx context.Context, id uuid.UUID) (int, error) {
+	var attempts int
+	err := r.pool.QueryRow(ctx, `
+		UPDATE business_transfers
+		SET attempts = attempts + 1, updated_at = now()
+		WHERE id = $1
+		RETURNING attempts`, id).Scan(&attempts)
+	if db.IsNotFound(err) {
+		return 0, domain.ErrTransferNotFound
+	}
+	if err != nil {
+		return 0, fmt.Errorf("update business_transfers: %w", err)
+	}
+	return attempts, nil
+}
+
+// Complete flips the transfer and moves the business to the new owner in
+// one transaction, so a crash can never leave the two out of step.
+func (r *TransferRepository) Complete(ctx context.Context, t *domain.BusinessTransfer) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transfer completion: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE business_transfers
+		SET status = 'completed', updated_at = now()
+		WHERE id = $1 AND status = 'pending'`, t.ID)
+	if err != nil {
+		return fmt.Errorf("update business_transfers: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTransferNotPending
+	}
+	_, err = tx.Exec(ctx, `
+		UPDATE online_businesses
+		SET user_id = $2, updated_by = $3, updated_at = now()
+		WHERE id = $1 AND deleted_at IS NULL`,
+		t.BusinessID, t.ToUserID, t.ToUserID)
+	if err != nil {
+		return fmt.Errorf("update online_businesses: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *TransferRepository) Cancel(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE business_transfers
+		SET status = 'canceled', updated_at = now()
+		WHERE id = $1 AND status = 'pending'`, id)
+	if err != nil {
+		return fmt.Errorf("update business_transfers: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTransferNotPending
+	}
+	return nil
+}
+
+func scanTransfer(row pgx.Row) (*domain.BusinessTransfer, error) {
+	var t domain.BusinessTransfer
+	err := row.Scan(&t.ID, &t.BusinessID, &t.FromUserID, &t.ToUserID, &t.Status,
+		&t.OTPHash, &t.OTPExpiresAt, &t.Attempts, &t.ExpiresAt, &t.CreatedAt, &t.UpdatedAt)
+	if db.IsNotFound(err) {
+		return nil, domain.ErrTransferNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select business_transfers: %w", err)
+	}
+	return &t, nil
+}
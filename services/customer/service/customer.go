@@ -0,0 +1,349 @@
+// Package service implements the customer service's use cases.
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// CustomerService manages merchant customers and their businesses.
+type CustomerService struct {
+	customers  domain.CustomerRepository
+	businesses domain.OnlineBusinessRepository
+	guilds     domain.GuildRepository
+	documents  domain.DocumentRepository
+	transfers  domain.TransferRepository
+	events     domain.EventPublisher
+	enamad     domain.EnamadVerifier
+	store      domain.ObjectStore
+	scanner    domain.VirusScanner
+	otp        domain.OTPSender
+	users      domain.UserDirectory
+	reports    domain.ReportRepository
+	txm        *db.TxManager
+	log        *slog.Logger
+}
+
+// Deps bundles the service's collaborators; optional ones (events,
+// enamad, store, scanner) may be nil, which disables the feature they
+// back rather than the whole service.
+type Deps struct {
+	Customers  domain.CustomerRepository
+	Businesses domain.OnlineBusinessRepository
+	Guilds     domain.GuildRepository
+	Documents  domain.DocumentRepository
+	Transfers  domain.TransferRepository
+	Events     domain.EventPublisher
+	Enamad     domain.EnamadVerifier
+	Store      domain.ObjectStore
+	Scanner    domain.VirusScanner
+	OTP        domain.OTPSender
+	// Users enables validation of user references against the user
+	// service; nil is the feature flag's off position.
+	Users   domain.UserDirectory
+	Reports domain.ReportRepository
+	// Tx makes multi-repository writes atomic; nil keeps each write in
+	// its own implicit transaction.
+	Tx *db.TxManager
+}
+
+// NewCustomerService builds the service.
+func NewCustomerService(deps Deps, log *slog.Logger) *CustomerService {
+	return &CustomerService{
+		customers:  deps.Customers,
+		businesses: deps.Businesses,
+		guilds:     deps.Guilds,
+		documents:  deps.Documents,
+		transfers:  deps.Transfers,
+		events:     deps.Events,
+		enamad:     deps.Enamad,
+		store:      deps.Store,
+		scanner:    deps.Scanner,
+		otp:        deps.OTP,
+		users:      deps.Users,
+		reports:    deps.Reports,
+		txm:        deps.Tx,
+		log:        log,
+	}
+}
+
+// withinTx runs fn transactionally when a TxManager is configured and
+// directly otherwise.
+func (s *CustomerService) withinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.txm == nil {
+		return fn(ctx)
+	}
+	return s.txm.WithinTx(ctx, fn)
+}
+
+// checkUserEligible validates a user reference against the user service
+// when the check is enabled.
+func (s *CustomerService) checkUserEligible(ctx context.Context, userID uuid.UUID) error {
+	if s.users == nil {
+		return nil
+	}
+	userStatus, err := s.users.Status(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("customer: user lookup: %w", err)
+	}
+	if !userStatus.Exists {
+		return domain.ErrUserUnknown
+	}
+	if !userStatus.KYCEligible {
+		return domain.ErrUserNotEligible
+	}
+	return nil
+}
+
+// ListGuilds returns the guild reference data for dropdowns.
+func (s *CustomerService) ListGuilds(ctx context.Context, activeOnly bool) ([]*domain.Guild, error) {
+	return s.guilds.List(ctx, activeOnly)
+}
+
+// publishEvent hands a business event to the outbox. Event loss is
+// logged but never fails the domain write that triggered it.
+func (s *CustomerService) publishEvent(ctx context.Context, name string, b *domain.OnlineBusiness, payload map[string]string) {
+	if s.events == nil {
+		return
+	}
+	err := s.events.Publish(ctx, domain.Event{
+		Name:       name,
+		BusinessID: b.ID,
+		UserID:     b.UserID,
+		OccurredAt: time.Now(),
+		Payload:    payload,
+	})
+	if err != nil {
+		s.log.Error("customer: publishing event failed", "event", name, "error", err)
+	}
+}
+
+// CreateBusiness registers a new pending business for the caller.
+func (s *CustomerService) CreateBusiness(ctx context.Context, callerUserID uuid.UUID, url, websiteName string) (*domain.OnlineBusiness, error) {
+	if err := s.checkUserEligible(ctx, callerUserID); err != nil {
+		return nil, err
+	}
+	business := &domain.OnlineBusiness{
+		ID:           uuid.New(),
+		UserID:       callerUserID,
+		URL:          url,
+		WebsiteName:  websiteName,
+		Status:       domain.BusinessPending,
+		Phones:       []string{},
+		EnamadStatus: domain.EnamadUnverified,
+		CreatedBy:    uuid.NullUUID{UUID: callerUserID, Valid: true},
+	}
+	err := s.withinTx(ctx, func(ctx context.Context) error {
+		if err := s.businesses.Create(ctx, business); err != nil {
+			return err
+		}
+		// Inside the transaction so the outbox row commits with the row
+		// it describes.
+		s.publishEvent(ctx, domain.EventBusinessCreated, business, map[string]string{"url": url})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: business created", "business_id", business.ID)
+	return business, nil
+}
+
+// MyBusinesses returns the caller's businesses.
+func (s *CustomerService) MyBusinesses(ctx context.Context, callerUserID uuid.UUID) ([]*domain.OnlineBusiness, error) {
+	return s.businesses.GetByUserID(ctx, callerUserID)
+}
+
+// GetCustomer returns a customer by ID.
+func (s *CustomerService) GetCustomer(ctx context.Context, customerID uuid.UUID) (*domain.Customer, error) {
+	return s.customers.GetByID(ctx, customerID)
+}
+
+// GetBusiness returns a business by ID.
+func (s *CustomerService) GetBusiness(ctx context.Context, businessID uuid.UUID) (*domain.OnlineBusiness, error) {
+	return s.businesses.GetByID(ctx, businessID)
+}
+
+// SetAsIndividual marks the caller's customer record as an individual.
+// Ownership is checked against the forwarded claims: only the user the
+// record belongs to may convert it. A corporate national ID on record is
+// cleared by the conversion.
+func (s *CustomerService) SetAsIndividual(ctx context.Context, callerUserID, customerID uuid.UUID) (*domain.Customer, error) {
+	customer, err := s.customers.GetByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if customer.UserID != callerUserID {
+		return nil, domain.ErrNotOwner
+	}
+	if customer.Type == domain.CustomerIndividual {
+		return customer, nil
+	}
+	updated, err := s.customers.SetType(ctx, customerID, domain.CustomerIndividual)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: marked as individual", "customer_id", customerID)
+	return updated, nil
+}
+
+// BusinessInfoUpdate carries the fields UpdateBusinessInfo may change;
+// nil pointers (and nil Phones) mean "leave unchanged", giving
+// field-mask semantics.
+type BusinessInfoUpdate struct {
+	Guild      *string
+	LicenseID  *string
+	PostalCode *string
+	Phones     []string
+}
+
+// UpdateBusinessInfo applies a partial update to a business's registry
+// fields after validation: postal codes must be well-formed Iranian
+// codes and phone numbers are normalized to their domestic form.
+func (s *CustomerService) UpdateBusinessInfo(ctx context.Context, callerUserID, businessID uuid.UUID, update BusinessInfoUpdate) (*domain.OnlineBusiness, error) {
+	business, err := s.businesses.GetByID(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+	if business.UserID != callerUserID {
+		return nil, domain.ErrNotOwner
+	}
+
+	if update.Guild != nil {
+		// Guild values are reference data, not free text.
+		if *update.Guild != "" {
+			known, err := s.guilds.Exists(ctx, *update.Guild)
+			if err != nil {
+				return nil, err
+			}
+			if !known {
+				return nil, fmt.Errorf("%w: %q", domain.ErrUnknownGuild, *update.Guild)
+			}
+		}
+		business.Guild = *update.Guild
+	}
+	if update.LicenseID != nil {
+		business.LicenseID = *update.LicenseID
+	}
+	if update.PostalCode != nil {
+		if !domain.ValidatePostalCode(*update.PostalCode) {
+			return nil, fmt.Errorf("%w: %q", domain.ErrInvalidPostalCode, *update.PostalCode)
+		}
+		business.PostalCode = *update.PostalCode
+	}
+	if update.Phones != nil {
+		normalized := make([]string, 0, len(update.Phones))
+		for _, phone := range update.Phones {
+			n, err := domain.NormalizePhone(phone)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q", err, phone)
+			}
+			normalized = append(normalized, n)
+		}
+		business.Phones = normalized
+	}
+	business.UpdatedBy = uuid.NullUUID{UUID: callerUserID, Valid: true}
+
+	updated, err := s.businesses.UpdateInfo(ctx, business)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: business info updated", "business_id", businessID)
+	s.publishEvent(ctx, domain.EventBusinessInfoUpdated, updated, nil)
+	return updated, nil
+}
+
+// UpdateFinancialData sets a business's tax ID and settlement IBAN. The
+// IBAN must pass the IR mod-97 checksum, be unused by other businesses,
+// and the issuing bank is derived from its bank code.
+func (s *CustomerService) UpdateFinancialData(ctx context.Context, callerUserID, businessID uuid.UUID, taxID, iban string) (*domain.OnlineBusiness, error) {
+	business, err := s.businesses.GetByID(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+	if business.UserID != callerUserID {
+		return nil, domain.ErrNotOwner
+	}
+
+	iban = domain.NormalizeIBAN(iban)
+	if !domain.ValidateIranianIBAN(iban) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidIBAN, iban)
+	}
+	inUse, err := s.businesses.IBANInUse(ctx, iban, businessID)
+	if err != nil {
+		return nil, err
+	}
+	if inUse {
+		return nil, domain.ErrDuplicateIBAN
+	}
+
+	business.TaxID = taxID
+	business.IBAN = iban
+	business.BankName = domain.BankFromIBAN(iban)
+	business.UpdatedBy = uuid.NullUUID{UUID: callerUserID, Valid: true}
+	updated, err := s.businesses.UpdateFinancial(ctx, business)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: financial data updated",
+		"business_id", businessID, "bank", updated.BankName)
+	return updated, nil
+}
+
+// DeleteBusiness soft-deletes a business owned by the caller. The row
+// stays in the table and can be restored by backoffice.
+func (s *CustomerService) DeleteBusiness(ctx context.Context, callerUserID, businessID uuid.UUID) error {
+	business, err := s.businesses.GetByID(ctx, businessID)
+	if err != nil {
+		return err
+	}
+	if business.UserID != callerUserID {
+		return domain.ErrNotOwner
+	}
+	if err := s.businesses.Delete(ctx, businessID, callerUserID); err != nil {
+		return err
+	}
+	s.log.Info("customer: business deleted", "business_id", businessID)
+	return nil
+}
+
+// RestoreBusiness undoes a soft delete. Authorization (backoffice only)
+// is the handler's job.
+func (s *CustomerService) RestoreBusiness(ctx context.Context, restoredBy, businessID uuid.UUID) (*domain.OnlineBusiness, error) {
+	business, err := s.businesses.Restore(ctx, businessID, restoredBy)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: business restored", "business_id", businessID)
+	return business, nil
+}
+
+// UpdateCorporateNationalID sets a corporate customer's legal national ID
+// (shenase melli) after checksum validation, guarded by the caller's
+// version for optimistic concurrency.
+func (s *CustomerService) UpdateCorporateNationalID(ctx context.Context, customerID uuid.UUID, nationalID string, version int64) (*domain.Customer, error) {
+	if !domain.ValidateLegalNationalID(nationalID) {
+		return nil, fmt.Errorf("%w: %q is not a valid shenase melli", domain.ErrInvalidNationalID, nationalID)
+	}
+	customer, err := s.customers.GetByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+	if customer.Type != domain.CustomerCorporate {
+		return nil, domain.ErrNotCorporate
+	}
+	updated, err := s.customers.UpdateNationalID(ctx, customerID, nationalID, version)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: corporate national id updated",
+		"customer_id", customerID, "version", updated.Version)
+	return updated, nil
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// ErrStorageUnavailable is returned when no object store is configured.
+var ErrStorageUnavailable = errors.New("customer: object storage is not configured")
+
+// uploadURLTTL bounds how long a presigned upload URL stays valid.
+const uploadURLTTL = 15 * time.Minute
+
+// allowedDocumentTypes lists the content types merchants may upload.
+var allowedDocumentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+// maxDocumentSize caps uploads at 10 MiB.
+const maxDocumentSize = 10 << 20
+
+// RequestDocumentUpload records a pending document and returns a
+// presigned PUT URL the client uploads directly to, keeping document
+// bytes out of the service.
+func (s *CustomerService) RequestDocumentUpload(ctx context.Context, callerUserID, businessID uuid.UUID, kind domain.DocumentKind, contentType string, sizeBytes int64) (*domain.Document, string, error) {
+	if s.store == nil {
+		return nil, "", ErrStorageUnavailable
+	}
+	if !allowedDocumentTypes[contentType] {
+		return nil, "", fmt.Errorf("customer: content type %q is not allowed", contentType)
+	}
+	if sizeBytes <= 0 || sizeBytes > maxDocumentSize {
+		return nil, "", fmt.Errorf("customer: document size %d is out of range", sizeBytes)
+	}
+	business, err := s.businesses.GetByID(ctx, businessID)
+	if err != nil {
+		return nil, "", err
+	}
+	if business.UserID != callerUserID {
+		return nil, "", domain.ErrNotOwner
+	}
+
+	document := &domain.Document{
+		ID:          uuid.New(),
+		BusinessID:  businessID,
+		Kind:        kind,
+		ContentType: contentType,
+		SizeBytes:   sizeBytes,
+		ScanStatus:  domain.ScanPending,
+		UploadedBy:  callerUserID,
+	}
+	document.ObjectKey = fmt.Sprintf("businesses/%s/documents/%s", businessID, document.ID)
+
+	uploadURL, err := s.store.PresignPut(document.ObjectKey, contentType, uploadURLTTL)
+	if err != nil {
+		return nil, "", fmt.Errorf("customer: presign upload: %w", err)
+	}
+	if err := s.documents.Create(ctx, document); err != nil {
+		return nil, "", err
+	}
+	s.log.Info("customer: document upload requested",
+		"business_id", businessID, "document_id", document.ID, "kind", kind)
+	return document, uploadURL, nil
+}
+
+// ConfirmDocumentUpload runs the virus-scan hook on an uploaded object
+// and records the verdict. Without a scanner configured the document is
+// accepted as clean — deployments that require scanning wire one in.
+func (s *CustomerService) ConfirmDocumentUpload(ctx context.Context, callerUserID, documentID uuid.UUID) (*domain.Document, error) {
+	if s.store == nil {
+		return nil, ErrStorageUnavailable
+	}
+	document, err := s.documents.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if document.UploadedBy != callerUserID {
+		return nil, domain.ErrNotOwner
+	}
+	if document.ScanStatus != domain.ScanPending {
+		return document, nil
+	}
+
+	// The presigned URL binds the content type but not the body length;
+	// verify what actually landed before the document can enter the
+	// approval workflow.
+	info, err := s.store.Stat(ctx, document.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if info.ContentType != document.ContentType || info.SizeBytes != document.SizeBytes {
+		return nil, fmt.Errorf("%w: got %q/%d, declared %q/%d", domain.ErrUploadMismatch,
+			info.ContentType, info.SizeBytes, document.ContentType, document.SizeBytes)
+	}
+
+	status := domain.ScanClean
+	if s.scanner != nil {
+		clean, err := s.scanner.ScanObject(ctx, document.ObjectKey)
+		if err != nil {
+			return nil, fmt.Errorf("customer: virus scan: %w", err)
+		}
+		if !clean {
+			status = domain.ScanInfected
+		}
+	}
+	updated, err := s.documents.SetScanStatus(ctx, documentID, status)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: document scan recorded",
+		"document_id", documentID, "status", status)
+	if status == domain.ScanInfected {
+		return updated, domain.ErrDocumentInfected
+	}
+	return updated, nil
+}
+
+// BusinessDocuments lists a business's documents for the owner or
+// backoffice.
+func (s *CustomerService) BusinessDocuments(ctx context.Context, businessID uuid.UUID) ([]*domain.Document, error) {
+	return s.documents.ListByBusiness(ctx, businessID)
+}
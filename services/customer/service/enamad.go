@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// ErrEnamadUnavailable is returned when no verifier is configured.
+var ErrEnamadUnavailable = errors.New("customer: enamad verification is not configured")
+
+// VerifyEnamad checks the caller's seal against their business URL and
+// records the outcome. A registry failure leaves the stored status
+// untouched rather than marking the seal failed.
+func (s *CustomerService) VerifyEnamad(ctx context.Context, callerUserID, businessID uuid.UUID, enamadID string) (*domain.OnlineBusiness, error) {
+	if s.enamad == nil {
+		return nil, ErrEnamadUnavailable
+	}
+	business, err := s.businesses.GetByID(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+	if business.UserID != callerUserID {
+		return nil, domain.ErrNotOwner
+	}
+
+	verification, err := s.enamad.Verify(ctx, enamadID, business.URL)
+	if err != nil {
+		return nil, fmt.Errorf("customer: enamad verification: %w", err)
+	}
+	status := domain.EnamadFailed
+	var expiresAt *time.Time
+	if verification.Valid {
+		status = domain.EnamadVerified
+		if !verification.ExpiresAt.IsZero() {
+			expiresAt = &verification.ExpiresAt
+		}
+	}
+	updated, err := s.businesses.UpdateEnamad(ctx, businessID, enamadID, status, expiresAt, callerUserID)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: enamad verified",
+		"business_id", businessID, "status", status)
+	return updated, nil
+}
+
+// ApproveBusiness moves a business to approved. Approval requires a
+// current, valid enamad verification; who may call this (backoffice) is
+// the handler's concern.
+func (s *CustomerService) ApproveBusiness(ctx context.Context, approvedBy, businessID uuid.UUID) (*domain.OnlineBusiness, error) {
+	business, err := s.businesses.GetByID(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+	if business.EnamadStatus != domain.EnamadVerified {
+		return nil, domain.ErrEnamadNotVerified
+	}
+	if business.EnamadExpiresAt != nil && !business.EnamadExpiresAt.After(time.Now()) {
+		return nil, domain.ErrEnamadNotVerified
+	}
+	// Review also needs a clean license scan on file.
+	hasLicense, err := s.documents.HasClean(ctx, businessID, domain.DocumentLicenseScan)
+	if err != nil {
+		return nil, err
+	}
+	if !hasLicense {
+		return nil, domain.ErrDocumentsRequired
+	}
+	updated, err := s.businesses.SetStatus(ctx, businessID, domain.BusinessApproved, approvedBy)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: business approved", "business_id", businessID)
+	s.publishEvent(ctx, domain.EventBusinessApproved, updated, nil)
+	return updated, nil
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// Import tuning.
+const (
+	// importMaxRows caps one upload; bigger migrations run in batches.
+	importMaxRows = 5000
+	// importEventsPerSecond paces business.created emission so a large
+	// import does not flood downstream consumers.
+	importEventsPerSecond = 50
+)
+
+// ImportFailure reports one rejected row.
+type ImportFailure struct {
+	// Line is 1-based and counts data rows (the CSV header is line 0).
+	Line   int
+	Ref    string
+	Reason string
+}
+
+// ImportReport summarizes a bulk import run.
+type ImportReport struct {
+	Total    int
+	Imported int
+	// Skipped rows were already imported in an earlier run (same
+	// external reference).
+	Skipped  int
+	Failures []ImportFailure
+}
+
+// importRow is one parsed input row.
+type importRow struct {
+	UserID      string `json:"user_id"`
+	URL         string `json:"url"`
+	WebsiteName string `json:"website_name"`
+	ExternalRef string `json:"external_ref"`
+}
+
+// ImportBusinesses loads businesses from a CSV or NDJSON upload. Rows
+// fail individually — one bad row never aborts the run — and re-running
+// the same file skips rows whose external reference already exists.
+func (s *CustomerService) ImportBusinesses(ctx context.Context, format string, data []byte, importedBy uuid.UUID) (*ImportReport, error) {
+	rows, parseFailures, err := parseImportRows(format, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows)+len(parseFailures) > importMaxRows {
+		return nil, fmt.Errorf("customer: import exceeds %d rows", importMaxRows)
+	}
+
+	report := &ImportReport{
+		Total:    len(rows) + len(parseFailures),
+		Failures: parseFailures,
+	}
+	pace := time.NewTicker(time.Second / importEventsPerSecond)
+	defer pace.Stop()
+
+	for i, row := range rows {
+		line := i + 1
+		fail := func(reason string) {
+			report.Failures = append(report.Failures, ImportFailure{Line: line, Ref: row.ExternalRef, Reason: reason})
+		}
+
+		if row.ExternalRef == "" {
+			fail("external_ref is required")
+			continue
+		}
+		if row.URL == "" || row.WebsiteName == "" {
+			fail("url and website_name are required")
+			continue
+		}
+		userID, err := uuid.Parse(row.UserID)
+		if err != nil {
+			fail("invalid user_id")
+			continue
+		}
+		exists, err := s.businesses.ExternalRefExists(ctx, row.ExternalRef)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			report.Skipped++
+			continue
+		}
+		if err := s.checkUserEligible(ctx, userID); err != nil {
+			fail(err.Error())
+			continue
+		}
+
+		business := &domain.OnlineBusiness{
+			ID:           uuid.New(),
+			UserID:       userID,
+			URL:          row.URL,
+			WebsiteName:  row.WebsiteName,
+			Status:       domain.BusinessPending,
+			Phones:       []string{},
+			ExternalRef:  row.ExternalRef,
+			EnamadStatus: domain.EnamadUnverified,
+			CreatedBy:    uuid.NullUUID{UUID: importedBy, Valid: true},
+		}
+		if err := s.businesses.Create(ctx, business); err != nil {
+			// A concurrent run may have won the external_ref race.
+			if errors.Is(err, domain.ErrDuplicateExternalRef) {
+				report.Skipped++
+				continue
+			}
+			fail(err.Error())
+			continue
+		}
+		report.Imported++
+
+		select {
+		case <-pace.C:
+		case <-ctx.Done():
+			return report, ctx.Err()
+		}
+		s.publishEvent(ctx, domain.EventBusinessCreated, business, map[string]string{
+			"url":          row.URL,
+			"external_ref": row.ExternalRef,
+		})
+	}
+
+	s.log.Info("customer: bulk import finished",
+		"total", report.Total, "imported", report.Imported,
+		"skipped", report.Skipped, "failed", len(report.Failures))
+	return report, nil
+}
+
+// parseImportRows decodes the upload into rows plus per-row failures for
+// lines that could not be decoded at all.
+func parseImportRows(format string, data []byte) ([]importRow, []ImportFailure, error) {
+	switch format {
+	case "csv":
+		return parseImportCSV(data)
+	case "ndjson":
+		return parseImportNDJSON(data)
+	default:
+		return nil, nil, fmt.Errorf("customer: unsupported import format %q", format)
+	}
+}
+
+func parseImportCSV(data []byte) ([]importRow, []ImportFailure, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("customer: parse csv: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("customer: empty import")
+	}
+
+	// The header names the columns, so order in the file doesn't matter.
+	index := map[string]int{}
+	for i, name := range records[0] {
+		index[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+	for _, required := range []string{"user_id", "url", "website_name", "external_ref"} {
+		if _, ok := index[required]; !ok {
+			return nil, nil, fmt.Errorf("customer: csv is missing the %q column", required)
+		}
+	}
+
+	rows := make([]importRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		rows = append(rows, importRow{
+			UserID:      strings.TrimSpace(record[index["user_id"]]),
+			URL:         strings.TrimSpace(record[index["url"]]),
+			WebsiteName: strings.TrimSpace(record[index["website_name"]]),
+			ExternalRef: strings.TrimSpace(record[index["external_ref"]]),
+		})
+	}
+	return rows, nil, nil
+}
+
+func parseImportNDJSON(data []byte) ([]importRow, []ImportFailure, error) {
+	var rows []importRow
+	var failures []ImportFailure
+	for i, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var row importRow
+		if err := json.Unmarshal(line, &row); err != nil {
+			failures = append(failures, ImportFailure{Line: i + 1, Reason: "invalid json"})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 && len(failures) == 0 {
+		return nil, nil, fmt.Errorf("customer: empty import")
+	}
+	return rows, failures, nil
+}
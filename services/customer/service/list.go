@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// Listing page caps.
+const (
+	defaultListPageSize = 20
+	maxListPageSize     = 100
+)
+
+// ErrBadPageToken is returned for tokens that did not come from a
+// previous response.
+var ErrBadPageToken = fmt.Errorf("customer: invalid page token")
+
+// ListBusinesses pages businesses matching the filter with keyset
+// pagination. The returned token is opaque to callers and empty on the
+// last page.
+func (s *CustomerService) ListBusinesses(ctx context.Context, filter domain.BusinessFilter, pageSize int, pageToken string, ascending bool) ([]*domain.OnlineBusiness, string, error) {
+	if pageSize <= 0 || pageSize > maxListPageSize {
+		pageSize = defaultListPageSize
+	}
+	cursor, err := decodePageToken(pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Fetch one extra row to learn whether another page exists without a
+	// second query.
+	businesses, err := s.businesses.List(ctx, filter, cursor, pageSize+1, ascending)
+	if err != nil {
+		return nil, "", err
+	}
+	nextToken := ""
+	if len(businesses) > pageSize {
+		businesses = businesses[:pageSize]
+		last := businesses[len(businesses)-1]
+		nextToken = encodePageToken(domain.BusinessCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return businesses, nextToken, nil
+}
+
+func encodePageToken(cursor domain.BusinessCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID.String()
+	return base64.URLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodePageToken(token string) (*domain.BusinessCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	raw, err := base64.URLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrBadPageToken
+	}
+	parts := strings.SplitN(string(raw), "|", 2)
+	if len(parts) != 2 {
+		return nil, ErrBadPageToken
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil {
+		return nil, ErrBadPageToken
+	}
+	id, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, ErrBadPageToken
+	}
+	return &domain.BusinessCursor{CreatedAt: createdAt, ID: id}, nil
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// OnboardingStep is one item of the merchant onboarding checklist.
+type OnboardingStep struct {
+	// Key identifies the step for the dashboard: "business_info",
+	// "financial_data", "documents", "enamad", "approval".
+	Key      string
+	Complete bool
+}
+
+// OnboardingProgress derives the checklist from domain state, so the
+// dashboard never has to guess which steps remain.
+func (s *CustomerService) OnboardingProgress(ctx context.Context, business *domain.OnlineBusiness) ([]OnboardingStep, error) {
+	hasLicense, err := s.documents.HasClean(ctx, business.ID, domain.DocumentLicenseScan)
+	if err != nil {
+		return nil, err
+	}
+	return []OnboardingStep{
+		{
+			Key:      "business_info",
+			Complete: business.Guild != "" && business.PostalCode != "" && len(business.Phones) > 0,
+		},
+		{
+			Key:      "financial_data",
+			Complete: business.IBAN != "",
+		},
+		{
+			Key:      "documents",
+			Complete: hasLicense,
+		},
+		{
+			Key:      "enamad",
+			Complete: business.EnamadStatus == domain.EnamadVerified,
+		},
+		{
+			Key:      "approval",
+			Complete: business.Status == domain.BusinessApproved,
+		},
+	}, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// defaultReportWindow is used when the caller gives no time range.
+const defaultReportWindow = 30 * 24 * time.Hour
+
+// maxReportWindow bounds one report so the date-bucketed queries stay
+// cheap; longer ranges are requested in slices.
+const maxReportWindow = 366 * 24 * time.Hour
+
+// BusinessReport assembles the backoffice onboarding report for
+// [from, to). Zero times default to the last 30 days.
+func (s *CustomerService) BusinessReport(ctx context.Context, from, to time.Time) (*domain.BusinessReport, error) {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-defaultReportWindow)
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("customer: report window is empty")
+	}
+	if to.Sub(from) > maxReportWindow {
+		return nil, fmt.Errorf("customer: report window exceeds %d days", int(maxReportWindow.Hours()/24))
+	}
+
+	report := &domain.BusinessReport{From: from, To: to}
+	var err error
+	if report.ByStatus, err = s.reports.CountByStatus(ctx); err != nil {
+		return nil, err
+	}
+	if report.ByGuild, err = s.reports.CountByGuild(ctx); err != nil {
+		return nil, err
+	}
+	if report.CreatedByDay, err = s.reports.CreatedByDay(ctx, from, to); err != nil {
+		return nil, err
+	}
+	if report.Latency, err = s.reports.ApprovalLatency(ctx, from, to); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
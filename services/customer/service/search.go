@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// clampPageSize bounds a requested page size to [1, maxSearchPageSize],
+// substituting the default for zero or negative values.
+func clampPageSize(size int) int {
+	if size <= 0 {
+		return defaultSearchPageSize
+	}
+	if size > maxSearchPageSize {
+		return maxSearchPageSize
+	}
+	return size
+}
+
+// SearchBusinesses finds businesses by fuzzy URL / website-name match,
+// optionally restricted to one owner. Pages are offset-based: page is
+// zero-indexed.
+func (s *CustomerService) SearchBusinesses(ctx context.Context, query string, userID uuid.UUID, pageSize, page int) ([]*domain.OnlineBusiness, error) {
+	pageSize = clampPageSize(pageSize)
+	if page < 0 {
+		page = 0
+	}
+	return s.businesses.Search(ctx, query, userID, pageSize, page*pageSize)
+}
+
+// SearchCustomers finds customers by exact national ID and/or user ID.
+func (s *CustomerService) SearchCustomers(ctx context.Context, nationalID string, userID uuid.UUID, pageSize, page int) ([]*domain.Customer, error) {
+	pageSize = clampPageSize(pageSize)
+	if page < 0 {
+		page = 0
+	}
+	return s.customers.Search(ctx, nationalID, userID, pageSize, page*pageSize)
+}
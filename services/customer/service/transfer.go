@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/customer/domain"
+)
+
+// ErrOTPSenderUnavailable is returned when no OTP sender is configured.
+var ErrOTPSenderUnavailable = errors.New("customer: transfer OTP delivery is not configured")
+
+// Transfer workflow tuning.
+const (
+	// transferTTL is how long an unconfirmed transfer stays open.
+	transferTTL = 24 * time.Hour
+	// transferOTPTTL is how long one confirmation code is valid.
+	transferOTPTTL = 5 * time.Minute
+	// transferOTPDigits matches the auth service's login codes.
+	transferOTPDigits = 6
+	// transferMaxAttempts cancels the transfer after this many wrong
+	// codes.
+	transferMaxAttempts = 5
+)
+
+// InitiateTransfer opens an ownership transfer from the current owner to
+// another user. The transfer stays pending until the new owner confirms
+// with an OTP or it expires.
+func (s *CustomerService) InitiateTransfer(ctx context.Context, callerUserID, businessID, toUserID uuid.UUID) (*domain.BusinessTransfer, error) {
+	if toUserID == callerUserID {
+		return nil, fmt.Errorf("customer: cannot transfer a business to its current owner")
+	}
+	business, err := s.businesses.GetByID(ctx, businessID)
+	if err != nil {
+		return nil, err
+	}
+	if business.UserID != callerUserID {
+		return nil, domain.ErrNotOwner
+	}
+	// The new owner must be a real, eligible user before anything is
+	// opened in their name.
+	if err := s.checkUserEligible(ctx, toUserID); err != nil {
+		return nil, err
+	}
+
+	transfer := &domain.BusinessTransfer{
+		ID:         uuid.New(),
+		BusinessID: businessID,
+		FromUserID: callerUserID,
+		ToUserID:   toUserID,
+		Status:     domain.TransferPending,
+		ExpiresAt:  time.Now().Add(transferTTL),
+	}
+	if err := s.transfers.Create(ctx, transfer); err != nil {
+		return nil, err
+	}
+	s.log.Info("customer: ownership transfer initiated",
+		"business_id", businessID, "transfer_id", transfer.ID)
+	return transfer, nil
+}
+
+// RequestTransferOTP sends a confirmation code to the new owner's
+// mobile. Only the designated new owner may ask for one.
+func (s *CustomerService) RequestTransferOTP(ctx context.Context, callerUserID uuid.UUID, callerMobile string, transferID uuid.UUID) error {
+	if s.otp == nil {
+		return ErrOTPSenderUnavailable
+	}
+	transfer, err := s.pendingTransfer(ctx, transferID)
+	if err != nil {
+		return err
+	}
+	if transfer.ToUserID != callerUserID {
+		return domain.ErrTransferNotFound
+	}
+
+	code, err := generateTransferOTP()
+	if err != nil {
+		return fmt.Errorf("customer: generate transfer code: %w", err)
+	}
+	if err := s.transfers.SetOTP(ctx, transferID, hashTransferOTP(code), time.Now().Add(transferOTPTTL)); err != nil {
+		return err
+	}
+	if err := s.otp.SendTransferOTP(ctx, callerMobile, code); err != nil {
+		return fmt.Errorf("customer: send transfer code: %w", err)
+	}
+	s.log.Info("customer: transfer code sent", "transfer_id", transferID)
+	return nil
+}
+
+// ConfirmTransfer completes the transfer when the new owner presents the
+// right code. Too many wrong codes cancel the transfer outright.
+func (s *CustomerService) ConfirmTransfer(ctx context.Context, callerUserID uuid.UUID, transferID uuid.UUID, code string) (*domain.BusinessTransfer, error) {
+	transfer, err := s.pendingTransfer(ctx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.ToUserID != callerUserID {
+		return nil, domain.ErrTransferNotFound
+	}
+	if transfer.OTPHash == "" || transfer.OTPExpiresAt == nil || time.Now().After(*transfer.OTPExpiresAt) {
+		return nil, domain.ErrTransferOTPInvalid
+	}
+	if subtle.ConstantTimeCompare([]byte(transfer.OTPHash), []byte(hashTransferOTP(code))) != 1 {
+		attempts, err := s.transfers.RecordAttempt(ctx, transferID)
+		if err != nil {
+			return nil, err
+		}
+		if attempts >= transferMaxAttempts {
+			if err := s.transfers.Cancel(ctx, transferID); err != nil {
+				return nil, err
+			}
+			s.log.Warn("customer: transfer canceled after repeated wrong codes",
+				"transfer_id", transferID)
+		}
+		return nil, domain.ErrTransferOTPInvalid
+	}
+
+	if err := s.transfers.Complete(ctx, transfer); err != nil {
+		return nil, err
+	}
+	transfer.Status = domain.TransferCompleted
+	s.log.Info("customer: ownership transfer completed",
+		"business_id", transfer.BusinessID, "transfer_id", transferID)
+	return transfer, nil
+}
+
+// ReassignBusinesses moves every business of one owner to another in a
+// single step, for backoffice account merges. Unlike user-initiated
+// transfers there is no OTP handshake — authorization is the handler's
+// job.
+func (s *CustomerService) ReassignBusinesses(ctx context.Context, actorID, fromUserID, toUserID uuid.UUID) (int64, error) {
+	if fromUserID == toUserID {
+		return 0, nil
+	}
+	moved, err := s.businesses.ReassignOwner(ctx, fromUserID, toUserID, actorID)
+	if err != nil {
+		return 0, err
+	}
+	s.log.Info("customer: businesses reassigned",
+		"from_user_id", fromUserID, "to_user_id", toUserID, "moved", moved, "actor_id", actorID)
+	return moved, nil
+}
+
+// CancelTransfer withdraws a pending transfer. Either side may cancel.
+func (s *CustomerService) CancelTransfer(ctx context.Context, callerUserID, transferID uuid.UUID) error {
+	transfer, err := s.transfers.GetByID(ctx, transferID)
+	if err != nil {
+		return err
+	}
+	if transfer.FromUserID != callerUserID && transfer.ToUserID != callerUserID {
+		return domain.ErrTransferNotFound
+	}
+	if err := s.transfers.Cancel(ctx, transferID); err != nil {
+		return err
+	}
+	s.log.Info("customer: ownership transfer canceled", "transfer_id", transferID)
+	return nil
+}
+
+// pendingTransfer loads a transfer and enforces the pending state and
+// overall expiry.
+func (s *CustomerService) pendingTransfer(ctx context.Context, transferID uuid.UUID) (*domain.BusinessTransfer, error) {
+	transfer, err := s.transfers.GetByID(ctx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.Status != domain.TransferPending {
+		return nil, domain.ErrTransferNotPending
+	}
+	if time.Now().After(transfer.ExpiresAt) {
+		if err := s.transfers.Cancel(ctx, transferID); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrTransferNotPending
+	}
+	return transfer, nil
+}
+
+func generateTransferOTP() (string, error) {
+	max := big.NewInt(1)
+	for i := 0; i < transferOTPDigits; i++ {
+		max.Mul(max, big.NewInt(10))
+	}
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", transferOTPDigits, n), nil
+}
+
+func hashTransferOTP(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,201 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// Plan is a commercial tier; limits are configured per plan, not per
+// key, so sales changes a key's plan instead of inventing numbers.
+type Plan string
+
+const (
+	PlanFree     Plan = "free"
+	PlanPartner  Plan = "partner"
+	PlanInternal Plan = "internal"
+)
+
+// PlanLimits splits short-term burst limiting from the billing quota:
+// the bucket protects the backends, the quota is the contract.
+type PlanLimits struct {
+	RequestsPerSecond float64
+	Burst             int
+	// MonthlyQuota caps requests per calendar month; zero is unmetered.
+	MonthlyQuota int64
+}
+
+// DefaultPlanLimits is the starting tier table.
+var DefaultPlanLimits = map[Plan]PlanLimits{
+	PlanFree:     {RequestsPerSecond: 5, Burst: 10, MonthlyQuota: 100_000},
+	PlanPartner:  {RequestsPerSecond: 50, Burst: 100, MonthlyQuota: 10_000_000},
+	PlanInternal: {RequestsPerSecond: 500, Burst: 1000},
+}
+
+// APIKey is a resolved key.
+type APIKey struct {
+	// ID names the key in metrics and billing; never the secret itself.
+	ID       string
+	Plan     Plan
+	Disabled bool
+}
+
+// ErrKeyUnknown is returned by stores for keys they did not issue.
+var ErrKeyUnknown = errors.New("gateway: unknown API key")
+
+// APIKeyStore resolves presented keys; implementations cache as they
+// see fit since every request goes through Lookup.
+type APIKeyStore interface {
+	Lookup(ctx context.Context, key string) (APIKey, error)
+}
+
+// apiUsage counts requests per key for billing; the quota check uses
+// its own in-memory window, this is the durable record.
+var apiUsage = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "infera",
+	Subsystem: "gateway",
+	Name:      "api_usage_total",
+	Help:      "Requests per API key and plan, including limited ones, by outcome.",
+}, []string{"key_id", "plan", "outcome"})
+
+// APIKeyLimiter enforces per-key burst limits and per-plan quotas on
+// the gateway's HTTP surface. Quota counting is per instance; a
+// multi-replica gateway needs the shared Redis counter behind the same
+// interface before quotas can be exact.
+type APIKeyLimiter struct {
+	store APIKeyStore
+	plans map[Plan]PlanLimits
+	log   *slog.Logger
+
+	mu   sync.Mutex
+	keys map[string]*keyState
+}
+
+type keyState struct {
+	tokens float64
+	last   time.Time
+	// usedThisMonth resets when month rolls over.
+	usedThisMonth int64
+	month         time.Month
+}
+
+// NewAPIKeyLimiter builds the limiter; nil plans uses
+// DefaultPlanLimits.
+func NewAPIKeyLimiter(store APIKeyStore, plans map[Plan]PlanLimits, log *slog.Logger) *APIKeyLimiter {
+	if plans == nil {
+		plans = DefaultPlanLimits
+	}
+	return &APIKeyLimiter{store: store, plans: plans, log: log, keys: make(map[string]*keyState)}
+}
+
+// Middleware authenticates the key and applies its limits. Burst
+// rejections and quota rejections carry distinct problem codes, since
+// the fix differs: back off versus upgrade the plan.
+func (l *APIKeyLimiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("X-API-Key")
+		if key == "" {
+			common.WriteProblem(w, r, common.Problem{
+				Status: http.StatusUnauthorized,
+				Detail: "missing X-API-Key header",
+				Code:   "api_key_missing",
+			})
+			return
+		}
+		resolved, err := l.store.Lookup(r.Context(), key)
+		if err != nil || resolved.Disabled {
+			if err != nil && !errors.Is(err, ErrKeyUnknown) {
+				l.log.Error("gateway: API key lookup failed", "error", err)
+			}
+			common.WriteProblem(w, r, common.Problem{
+				Status: http.StatusUnauthorized,
+				Detail: "invalid API key",
+				Code:   "api_key_invalid",
+			})
+			return
+		}
+
+		switch l.take(resolved.ID, l.limitsFor(resolved.Plan)) {
+		case takeOK:
+			apiUsage.WithLabelValues(resolved.ID, string(resolved.Plan), "ok").Inc()
+			next.ServeHTTP(w, r)
+		case takeBurst:
+			apiUsage.WithLabelValues(resolved.ID, string(resolved.Plan), "rate_limited").Inc()
+			w.Header().Set("Retry-After", "1")
+			common.WriteProblem(w, r, common.Problem{
+				Status: http.StatusTooManyRequests,
+				Detail: "request rate exceeds the plan's burst limit",
+				Code:   "rate_limited",
+			})
+		case takeQuota:
+			apiUsage.WithLabelValues(resolved.ID, string(resolved.Plan), "quota_exceeded").Inc()
+			common.WriteProblem(w, r, common.Problem{
+				Status: http.StatusTooManyRequests,
+				Detail: "monthly quota exhausted for this plan",
+				Code:   "quota_exceeded",
+			})
+		}
+	})
+}
+
+// limitsFor resolves a plan's limits. An unknown plan — a typo in the
+// store — must not hand out a zero-valued bucket that 429s every
+// request; it gets the free tier and a log line instead.
+func (l *APIKeyLimiter) limitsFor(plan Plan) PlanLimits {
+	if limits, ok := l.plans[plan]; ok {
+		return limits
+	}
+	l.log.Warn("gateway: unknown API key plan, falling back to free tier", "plan", string(plan))
+	if limits, ok := l.plans[PlanFree]; ok {
+		return limits
+	}
+	return DefaultPlanLimits[PlanFree]
+}
+
+type takeResult int
+
+const (
+	takeOK takeResult = iota
+	takeBurst
+	takeQuota
+)
+
+// take charges one request against the key's bucket and quota.
+func (l *APIKeyLimiter) take(keyID string, limits PlanLimits) takeResult {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	state, ok := l.keys[keyID]
+	if !ok {
+		state = &keyState{tokens: float64(limits.Burst), last: now, month: now.Month()}
+		l.keys[keyID] = state
+	}
+	if state.month != now.Month() {
+		state.month = now.Month()
+		state.usedThisMonth = 0
+	}
+	if limits.MonthlyQuota > 0 && state.usedThisMonth >= limits.MonthlyQuota {
+		return takeQuota
+	}
+
+	state.tokens += now.Sub(state.last).Seconds() * limits.RequestsPerSecond
+	if max := float64(limits.Burst); state.tokens > max {
+		state.tokens = max
+	}
+	state.last = now
+	if state.tokens < 1 {
+		return takeBurst
+	}
+	state.tokens--
+	state.usedThisMonth++
+	return takeOK
+}
@@ -0,0 +1,84 @@
+package gateway
+
+import (
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// ChaosRule injects one kind of fault into a percentage of matching
+// requests. Exactly one of Latency, Status, or Drop should be set; a
+// rule with several set applies them in that order.
+type ChaosRule struct {
+	// PathPrefix selects routes; empty matches everything.
+	PathPrefix string
+	// Percent of matching requests to fault, 0–100.
+	Percent float64
+	// Latency delays the request before handling it.
+	Latency time.Duration
+	// Status short-circuits with this HTTP status instead of handling.
+	Status int
+	// Drop aborts the connection mid-request, the way a crashed pod
+	// does — the client sees a reset, not a status.
+	Drop bool
+}
+
+// ChaosConfig is the fault-injection setup. It is wired from config in
+// staging and test environments only; production configs must not set
+// Enabled, and the middleware logs loudly at startup when it is on so
+// an accidental enable is visible.
+type ChaosConfig struct {
+	Enabled bool
+	Rules   []ChaosRule
+}
+
+// ChaosMiddleware wraps a handler with fault injection per ChaosConfig.
+// It exists to prove that the resilience machinery — client retries,
+// breakers, hedging — actually fires; run the loadtest harness against
+// a gateway with chaos on and watch the client-side metrics.
+func ChaosMiddleware(cfg ChaosConfig, log *slog.Logger) func(http.Handler) http.Handler {
+	if !cfg.Enabled || len(cfg.Rules) == 0 {
+		return func(next http.Handler) http.Handler { return next }
+	}
+	log.Warn("gateway: chaos middleware ENABLED — never run this in production", "rules", len(cfg.Rules))
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			for _, rule := range cfg.Rules {
+				if !strings.HasPrefix(r.URL.Path, rule.PathPrefix) {
+					continue
+				}
+				if rand.Float64()*100 >= rule.Percent {
+					continue
+				}
+				if rule.Latency > 0 {
+					select {
+					case <-time.After(rule.Latency):
+					case <-r.Context().Done():
+						return
+					}
+				}
+				if rule.Drop {
+					// ErrAbortHandler makes net/http sever the
+					// connection without writing a response.
+					panic(http.ErrAbortHandler)
+				}
+				if rule.Status > 0 {
+					common.WriteProblem(w, r, common.Problem{
+						Status: rule.Status,
+						Detail: "injected fault",
+						Code:   "chaos_injected",
+					})
+					return
+				}
+				// Latency-only rule: fall through to the real handler
+				// after the delay.
+				break
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
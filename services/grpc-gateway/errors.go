@@ -0,0 +1,30 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// problemErrorHandler translates gRPC statuses into RFC 7807 problem
+// responses. The gRPC code becomes our code extension ("not_found",
+// "permission_denied", ...), and the status message — already written
+// for clients by the services' mapError functions — becomes the detail.
+func problemErrorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	common.WriteProblem(w, r, common.Problem{
+		Status: runtime.HTTPStatusFromCode(st.Code()),
+		Detail: st.Message(),
+		Code:   st.Code().String(),
+	})
+}
+
+// problemRoutingErrorHandler covers errors that never reach a backend:
+// unknown paths and bad methods.
+func problemRoutingErrorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, httpStatus int) {
+	common.WriteProblem(w, r, common.Problem{Status: httpStatus})
+}
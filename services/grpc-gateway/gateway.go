@@ -0,0 +1,208 @@
+// Package gateway implements the internal REST transcoding gateway: a
+// config-driven grpc-gateway mux that fronts whichever backends a
+// deployment lists, so internal tools get REST access to the gRPC
+// services without touching the public edge.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/K-H-Tech/infera/core/locale"
+	"github.com/K-H-Tech/infera/core/metric"
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// RegisterFunc hooks one set of generated gateway handlers onto the
+// mux, e.g. userv1.RegisterUserServiceHandler.
+type RegisterFunc func(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error
+
+// Backend describes one upstream gRPC service.
+type Backend struct {
+	// Name identifies the backend in status output and logs.
+	Name string
+	// Address is the gRPC target, e.g. "user:9090".
+	Address string
+	// Register lists the handler registrations to mount for this
+	// backend; a backend may expose several services.
+	Register []RegisterFunc
+	// DialOptions extend the defaults (in-mesh plaintext transport);
+	// backends needing TLS or per-backend interceptors set them here.
+	DialOptions []grpc.DialOption
+}
+
+// Config is the gateway's backend list and edge policy.
+type Config struct {
+	Backends []Backend
+	// APIKeyStore enables per-key rate limiting on the transcoded
+	// routes; nil leaves them open, for in-mesh deployments that
+	// authenticate elsewhere.
+	APIKeyStore APIKeyStore
+	// Plans overrides DefaultPlanLimits; ignored without APIKeyStore.
+	Plans map[Plan]PlanLimits
+	// Chaos is the fault-injection setup for resilience testing; only
+	// staging and test configs set Enabled.
+	Chaos ChaosConfig
+	// SLOs lists the per-route objectives; the gateway observes every
+	// transcoded request against them and emits burn-rate and
+	// error-budget metrics. Empty disables SLO tracking.
+	SLOs []metric.Objective
+}
+
+// Gateway holds the mux and the backend connections behind it.
+type Gateway struct {
+	mux       *runtime.ServeMux
+	conns     map[string]*grpc.ClientConn
+	readiness *common.Readiness
+	drainer   *common.Drainer
+	apiKeys   *APIKeyLimiter
+	chaos     func(http.Handler) http.Handler
+	slo       *metric.Tracker
+	log       *slog.Logger
+}
+
+// New dials every backend and mounts its handlers. Dialing is lazy at
+// the transport level, so a down backend delays its first request, not
+// the gateway's start; readiness reports it honestly in the meantime.
+func New(ctx context.Context, cfg Config, log *slog.Logger) (*Gateway, error) {
+	g := &Gateway{
+		mux: runtime.NewServeMux(
+			runtime.WithErrorHandler(problemErrorHandler),
+			runtime.WithRoutingErrorHandler(problemRoutingErrorHandler),
+			runtime.WithMetadata(localeMetadata),
+		),
+		conns:     make(map[string]*grpc.ClientConn),
+		readiness: common.NewReadiness(),
+		drainer:   common.NewDrainer(),
+		log:       log,
+	}
+	g.readiness.Register("draining", g.drainer.Check)
+	if cfg.APIKeyStore != nil {
+		g.apiKeys = NewAPIKeyLimiter(cfg.APIKeyStore, cfg.Plans, log)
+	}
+	g.chaos = ChaosMiddleware(cfg.Chaos, log)
+	if len(cfg.SLOs) > 0 {
+		g.slo = metric.NewTracker(cfg.SLOs, log)
+	}
+	for _, backend := range cfg.Backends {
+		if err := g.addBackend(ctx, backend); err != nil {
+			g.Close()
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+func (g *Gateway) addBackend(ctx context.Context, backend Backend) error {
+	opts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, backend.DialOptions...)
+	conn, err := grpc.NewClient(backend.Address, opts...)
+	if err != nil {
+		return fmt.Errorf("gateway: dial %s: %w", backend.Name, err)
+	}
+	g.conns[backend.Name] = conn
+
+	for _, register := range backend.Register {
+		if err := register(ctx, g.mux, conn); err != nil {
+			return fmt.Errorf("gateway: register %s: %w", backend.Name, err)
+		}
+	}
+	g.readiness.Register(backend.Name, backendCheck(conn))
+	g.log.Info("gateway: backend mounted", "backend", backend.Name, "address", backend.Address)
+	return nil
+}
+
+// backendCheck reports the connection state as readiness: a backend
+// stuck in transient failure or shut down makes /readiness fail.
+func backendCheck(conn *grpc.ClientConn) common.CheckFunc {
+	return func(ctx context.Context) error {
+		switch state := conn.GetState(); state {
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return fmt.Errorf("connection %s", state)
+		default:
+			return nil
+		}
+	}
+}
+
+// Handler returns the gateway's HTTP surface: /healthz for liveness,
+// /readiness for the backend checks, /status for a per-backend state
+// dump, /drain to start connection draining before a rollout, and
+// everything else transcoded onto the backends. The probe and admin
+// endpoints sit outside the drain middleware so a draining pod stays
+// observable.
+func (g *Gateway) Handler() http.Handler {
+	root := http.NewServeMux()
+	root.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	root.Handle("/readiness", g.readiness.Handler())
+	root.HandleFunc("/status", g.status)
+	root.Handle("/drain", g.drainer.Handler())
+	// Transcoded routes: chaos faults innermost (so they hit requests
+	// that passed the edge checks), then API-key limits, then the drain
+	// check — a draining pod answers 503 before spending limiter
+	// budget.
+	transcoded := g.chaos(g.mux)
+	if g.apiKeys != nil {
+		transcoded = g.apiKeys.Middleware(transcoded)
+	}
+	// SLO observation wraps the whole transcoded stack, so the metrics
+	// reflect what clients actually experienced — injected faults
+	// included.
+	if g.slo != nil {
+		transcoded = g.slo.Middleware(transcoded)
+	}
+	root.Handle("/", g.drainer.Middleware(transcoded))
+	return locale.Middleware(root)
+}
+
+// Drainer exposes the drain coordinator so main can block on it during
+// shutdown or register worker queues to wait for.
+func (g *Gateway) Drainer() *common.Drainer {
+	return g.drainer
+}
+
+// Run drives the gateway's background workers — currently the SLO
+// tracker's gauge publishing — until ctx ends. Start it alongside the
+// HTTP server.
+func (g *Gateway) Run(ctx context.Context) {
+	if g.slo != nil {
+		g.slo.Run(ctx)
+		return
+	}
+	<-ctx.Done()
+}
+
+// localeMetadata forwards the resolved locale to backends on every
+// transcoded call.
+func localeMetadata(ctx context.Context, _ *http.Request) metadata.MD {
+	return metadata.Pairs(locale.MetadataKey, locale.FromContext(ctx))
+}
+
+// status reports each backend's connectivity state.
+func (g *Gateway) status(w http.ResponseWriter, _ *http.Request) {
+	states := make(map[string]string, len(g.conns))
+	for name, conn := range g.conns {
+		states[name] = conn.GetState().String()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(states)
+}
+
+// Close tears down the backend connections.
+func (g *Gateway) Close() {
+	for _, conn := range g.conns {
+		conn.Close()
+	}
+}
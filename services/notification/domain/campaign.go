@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrCampaignNotFound is returned for unknown campaign IDs.
+var ErrCampaignNotFound = errors.New("notification: campaign not found")
+
+// Campaign is one bulk send: a template fanned out to a recipient segment.
+// Each recipient becomes a regular queue message tagged with the campaign
+// ID, so retries, preferences, and the DLQ all apply per recipient.
+type Campaign struct {
+	ID       uuid.UUID
+	Name     string
+	Template string
+	Locale   string
+	Category string
+	// Params are shared across recipients; per-recipient values come from
+	// the segment.
+	Params map[string]string
+	// Total is the number of recipients fanned out.
+	Total     int
+	CreatedAt time.Time
+}
+
+// CampaignProgress is the per-status message count for a campaign.
+type CampaignProgress struct {
+	Queued     int64
+	Sending    int64
+	Sent       int64
+	Dead       int64
+	Suppressed int64
+	Canceled   int64
+}
+
+// CampaignRepository persists campaigns and answers progress queries over
+// their queue messages.
+type CampaignRepository interface {
+	Create(ctx context.Context, c *Campaign) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Campaign, error)
+	// Progress counts the campaign's messages grouped by status.
+	Progress(ctx context.Context, id uuid.UUID) (*CampaignProgress, error)
+}
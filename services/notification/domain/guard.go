@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DispatchGuardStore answers the queries behind dispatch-time guards:
+// per-recipient volume and duplicate detection.
+type DispatchGuardStore interface {
+	// DeliveredCountSince counts messages delivered (or currently being
+	// delivered) to the recipient since the given time.
+	DeliveredCountSince(ctx context.Context, recipient string, since time.Time) (int, error)
+	// DuplicateExists reports whether another message with the same
+	// recipient, template, and params was dispatched since the given time.
+	DuplicateExists(ctx context.Context, msg *Message, since time.Time) (bool, error)
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrMessageNotFound is returned for unknown queue message IDs.
+var ErrMessageNotFound = errors.New("notification: message not found")
+
+// ErrMessageNotCancelable is returned when cancellation arrives after the
+// message left the queued state.
+var ErrMessageNotCancelable = errors.New("notification: message is no longer cancelable")
+
+// ErrDuplicateIdempotencyKey is returned by Enqueue when the key was
+// already used; callers fetch the original with GetByIdempotencyKey.
+var ErrDuplicateIdempotencyKey = errors.New("notification: idempotency key already used")
+
+// MessageStatus is the lifecycle of a queued message.
+type MessageStatus string
+
+const (
+	// MessageQueued waits for a worker.
+	MessageQueued MessageStatus = "queued"
+	// MessageSending is claimed by a worker.
+	MessageSending MessageStatus = "sending"
+	// MessageSent was accepted by the provider.
+	MessageSent MessageStatus = "sent"
+	// MessageDead exhausted its retries and sits in the dead-letter queue
+	// until an operator requeues or discards it.
+	MessageDead MessageStatus = "dead"
+	// MessageSuppressed was dropped at dispatch time because the recipient
+	// opted out of its category.
+	MessageSuppressed MessageStatus = "suppressed"
+	// MessageCanceled was withdrawn by the caller before dispatch.
+	MessageCanceled MessageStatus = "canceled"
+)
+
+// Message is one queued notification.
+type Message struct {
+	ID        uuid.UUID
+	Channel   string
+	Recipient string
+	Template  string
+	Locale    string
+	// Category classifies the message for preference enforcement; empty
+	// means transactional.
+	Category string
+	// CampaignID links bulk-send messages back to their campaign.
+	CampaignID uuid.NullUUID
+	// IdempotencyKey deduplicates client retries: a second enqueue with
+	// the same key returns the original message instead of sending again.
+	IdempotencyKey string
+	Params         map[string]string
+	Status         MessageStatus
+	// Attempts counts delivery tries so far.
+	Attempts int
+	// NextAttemptAt is when the message becomes due again after a retry
+	// backoff.
+	NextAttemptAt time.Time
+	LastError     string
+	// ProviderMessageID is set once a provider accepts the message.
+	ProviderMessageID string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// Channel names.
+const (
+	ChannelSMS = "sms"
+	// ChannelWebhook messages carry an event payload in Params, the event
+	// name in Template, and the endpoint ID in Recipient.
+	ChannelWebhook = "webhook"
+)
+
+// HistoryFilter narrows history queries; zero values mean "any".
+type HistoryFilter struct {
+	Recipient string
+	Template  string
+	Status    MessageStatus
+	// From and To bound CreatedAt.
+	From time.Time
+	To   time.Time
+}
+
+// MessageQueue is the persistent dispatch queue.
+type MessageQueue interface {
+	Enqueue(ctx context.Context, msg *Message) error
+	// ClaimDue atomically claims up to limit due messages for a worker,
+	// moving them to sending.
+	ClaimDue(ctx context.Context, now time.Time, limit int) ([]*Message, error)
+	MarkSent(ctx context.Context, id uuid.UUID, providerMessageID string) error
+	// MarkRetry schedules another attempt.
+	MarkRetry(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error
+	// Defer pushes the message to a later dispatch time without counting
+	// an attempt, for send-window policies.
+	Defer(ctx context.Context, id uuid.UUID, until time.Time) error
+	// MarkDead moves the message to the dead-letter queue.
+	MarkDead(ctx context.Context, id uuid.UUID, lastError string) error
+	// MarkSuppressed records a preference-based drop; suppressed messages
+	// are terminal and never retried.
+	MarkSuppressed(ctx context.Context, id uuid.UUID, reason string) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Message, error)
+	GetByIdempotencyKey(ctx context.Context, key string) (*Message, error)
+	ListDead(ctx context.Context, limit, offset int) ([]*Message, int64, error)
+	// ListHistory pages every outbound message matching the filter, newest
+	// first, for support investigations.
+	ListHistory(ctx context.Context, filter HistoryFilter, limit, offset int) ([]*Message, int64, error)
+	// Requeue returns a dead message to the queue with a clean attempt
+	// budget.
+	Requeue(ctx context.Context, id uuid.UUID) error
+	// Cancel withdraws a message that has not been dispatched yet; it
+	// returns ErrMessageNotCancelable once delivery has started.
+	Cancel(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPreferencesNotFound is returned when a recipient has never saved
+// preferences; callers should fall back to DefaultPreferences.
+var ErrPreferencesNotFound = errors.New("notification: preferences not found")
+
+// Message categories. Transactional messages (OTPs, security alerts) are
+// always delivered; only marketing respects opt-outs.
+const (
+	CategoryTransactional = "transactional"
+	CategoryMarketing     = "marketing"
+)
+
+// Preferences holds a recipient's delivery choices, keyed by mobile.
+type Preferences struct {
+	Mobile string
+	// Locale overrides the locale requested by the sender when set.
+	Locale string
+	// OptOuts maps OptOutKey(channel, category) to true for suppressed
+	// combinations.
+	OptOuts   map[string]bool
+	UpdatedAt time.Time
+}
+
+// DefaultPreferences is what a recipient gets before saving anything:
+// everything delivered, no locale override.
+func DefaultPreferences(mobile string) *Preferences {
+	return &Preferences{Mobile: mobile, OptOuts: map[string]bool{}}
+}
+
+// OptOutKey builds the opt-out map key for a channel/category pair.
+func OptOutKey(channel, category string) string {
+	return channel + ":" + category
+}
+
+// Allows reports whether a message in the given channel and category may
+// be delivered. Transactional traffic is never suppressed.
+func (p *Preferences) Allows(channel, category string) bool {
+	if category == "" || category == CategoryTransactional {
+		return true
+	}
+	return !p.OptOuts[OptOutKey(channel, category)]
+}
+
+// PreferenceRepository persists recipient preferences.
+type PreferenceRepository interface {
+	Get(ctx context.Context, mobile string) (*Preferences, error)
+	Upsert(ctx context.Context, prefs *Preferences) error
+}
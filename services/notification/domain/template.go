@@ -0,0 +1,49 @@
+// Package domain holds the notification service's entities and repository
+// contracts.
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrTemplateNotFound is returned when no active template exists for the
+// requested name and locale.
+var ErrTemplateNotFound = errors.New("notification: template not found")
+
+// Template is one versioned, localized message template. Publishing a new
+// version never mutates an old one; the latest active version per
+// (name, locale) wins at render time, and old versions stay around so
+// history rows can reference exactly what was sent.
+type Template struct {
+	ID     uuid.UUID
+	Name   string
+	Locale string
+	// Version increments per (name, locale); assigned by the repository.
+	Version int
+	// Body is the message text with Go text/template placeholders, e.g.
+	// "کد ورود شما: {{.code}}". Empty when the provider renders the
+	// message itself.
+	Body string
+	// ProviderRef is the provider-side template identifier used when Body
+	// is empty (e.g. a Kavenegar verify-lookup template name).
+	ProviderRef string
+	Active      bool
+	CreatedAt   time.Time
+}
+
+// TemplateRepository persists templates.
+type TemplateRepository interface {
+	// GetActive returns the highest active version for (name, locale).
+	GetActive(ctx context.Context, name, locale string) (*Template, error)
+	// Create inserts a new version for (name, locale) and returns it with
+	// the assigned version number.
+	Create(ctx context.Context, template *Template) (*Template, error)
+	// Deactivate retires one template version.
+	Deactivate(ctx context.Context, id uuid.UUID) error
+	// List returns all versions for a name, every locale, newest first.
+	List(ctx context.Context, name string) ([]*Template, error)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrWebhookEndpointNotFound is returned for unknown endpoint IDs.
+var ErrWebhookEndpointNotFound = errors.New("notification: webhook endpoint not found")
+
+// WebhookEndpoint is a customer-configured URL that receives signed event
+// payloads.
+type WebhookEndpoint struct {
+	ID uuid.UUID
+	// CustomerID is the owning customer, for backoffice filtering.
+	CustomerID string
+	URL        string
+	// Secret signs payloads; the merchant verifies the HMAC with it.
+	Secret string
+	// Events lists the event names delivered to this endpoint; empty means
+	// all events.
+	Events    []string
+	Active    bool
+	CreatedAt time.Time
+}
+
+// Subscribed reports whether the endpoint wants the named event.
+func (e *WebhookEndpoint) Subscribed(event string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, name := range e.Events {
+		if name == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEndpointRepository persists webhook endpoints.
+type WebhookEndpointRepository interface {
+	Create(ctx context.Context, e *WebhookEndpoint) error
+	GetByID(ctx context.Context, id uuid.UUID) (*WebhookEndpoint, error)
+	ListActive(ctx context.Context) ([]*WebhookEndpoint, error)
+	ListByCustomer(ctx context.Context, customerID string) ([]*WebhookEndpoint, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
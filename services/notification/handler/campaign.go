@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+func (h *NotificationHandler) StartCampaign(ctx context.Context, req *notificationv1.StartCampaignRequest) (*notificationv1.StartCampaignResponse, error) {
+	var scheduleAt time.Time
+	if req.GetScheduleAt() != nil {
+		scheduleAt = req.GetScheduleAt().AsTime()
+	}
+	campaign, err := h.campaigns.Start(ctx,
+		req.GetName(), req.GetTemplate(), req.GetLocale(), req.GetCategory(),
+		req.GetParams(), req.GetRecipients(), scheduleAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &notificationv1.StartCampaignResponse{
+		CampaignId: campaign.ID.String(),
+		Total:      int32(campaign.Total),
+	}, nil
+}
+
+func (h *NotificationHandler) GetCampaignProgress(ctx context.Context, req *notificationv1.GetCampaignProgressRequest) (*notificationv1.GetCampaignProgressResponse, error) {
+	id, err := uuid.Parse(req.GetCampaignId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid campaign_id")
+	}
+	campaign, progress, err := h.campaigns.Progress(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrCampaignNotFound) {
+			return nil, status.Error(codes.NotFound, "campaign not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &notificationv1.GetCampaignProgressResponse{
+		Name:       campaign.Name,
+		Total:      int32(campaign.Total),
+		Queued:     progress.Queued,
+		Sending:    progress.Sending,
+		Sent:       progress.Sent,
+		Dead:       progress.Dead,
+		Suppressed: progress.Suppressed,
+		Canceled:   progress.Canceled,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+	"github.com/K-H-Tech/infera/services/notification/domain"
+	"github.com/K-H-Tech/infera/services/notification/provider"
+	"github.com/K-H-Tech/infera/services/notification/service"
+)
+
+// NotificationHandler adapts the queue service to the notification.v1 gRPC
+// API. Sends enqueue and return immediately; the dispatcher workers deliver
+// asynchronously.
+type NotificationHandler struct {
+	notificationv1.UnimplementedNotificationServiceServer
+
+	queue     *service.QueueService
+	templates *service.TemplateService
+	prefs     *service.PreferenceService
+	campaigns *service.CampaignService
+	webhooks  *service.WebhookService
+	// sandbox is set via WithSandbox in non-production environments only.
+	sandbox *provider.Sandbox
+}
+
+// NewNotificationHandler builds the notification handler.
+func NewNotificationHandler(queue *service.QueueService, templates *service.TemplateService, prefs *service.PreferenceService, campaigns *service.CampaignService, webhooks *service.WebhookService) *NotificationHandler {
+	return &NotificationHandler{queue: queue, templates: templates, prefs: prefs, campaigns: campaigns, webhooks: webhooks}
+}
+
+func (h *NotificationHandler) SendOTP(ctx context.Context, req *notificationv1.SendOTPRequest) (*notificationv1.SendOTPResponse, error) {
+	if req.GetMobile() == "" || req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mobile and code are required")
+	}
+	// The code travels as both "code" and "token" so local bodies and
+	// provider-side templates can each pick their parameter name.
+	params := map[string]string{"code": req.GetCode(), "token": req.GetCode()}
+	// OTPs are time-sensitive and never scheduled.
+	msg, duplicate, err := h.queue.EnqueueSMS(ctx, req.GetMobile(), req.GetTemplate(), req.GetLocale(), domain.CategoryTransactional, req.GetIdempotencyKey(), params, time.Time{})
+	if err != nil {
+		return nil, mapQueueError(err)
+	}
+	return &notificationv1.SendOTPResponse{
+		MessageId: msg.ID.String(),
+		Status:    string(msg.Status),
+		Duplicate: duplicate,
+	}, nil
+}
+
+func (h *NotificationHandler) SendSMS(ctx context.Context, req *notificationv1.SendSMSRequest) (*notificationv1.SendSMSResponse, error) {
+	if req.GetMobile() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mobile is required")
+	}
+	var scheduleAt time.Time
+	if req.GetScheduleAt() != nil {
+		scheduleAt = req.GetScheduleAt().AsTime()
+	}
+	msg, duplicate, err := h.queue.EnqueueSMS(ctx, req.GetMobile(), req.GetTemplate(), req.GetLocale(), req.GetCategory(), req.GetIdempotencyKey(), req.GetParams(), scheduleAt)
+	if err != nil {
+		return nil, mapQueueError(err)
+	}
+	return &notificationv1.SendSMSResponse{
+		MessageId: msg.ID.String(),
+		Status:    string(msg.Status),
+		Duplicate: duplicate,
+	}, nil
+}
+
+func (h *NotificationHandler) CancelMessage(ctx context.Context, req *notificationv1.CancelMessageRequest) (*notificationv1.CancelMessageResponse, error) {
+	id, err := uuid.Parse(req.GetMessageId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid message_id")
+	}
+	if err := h.queue.Cancel(ctx, id); err != nil {
+		return nil, mapQueueError(err)
+	}
+	return &notificationv1.CancelMessageResponse{}, nil
+}
+
+func (h *NotificationHandler) ListDeadMessages(ctx context.Context, req *notificationv1.ListDeadMessagesRequest) (*notificationv1.ListDeadMessagesResponse, error) {
+	messages, total, err := h.queue.ListDead(ctx, int(req.GetPageSize()), int(req.GetOffset()))
+	if err != nil {
+		return nil, mapQueueError(err)
+	}
+	out := make([]*notificationv1.QueuedMessage, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, queuedMessageToProto(m))
+	}
+	return &notificationv1.ListDeadMessagesResponse{Messages: out, Total: total}, nil
+}
+
+func (h *NotificationHandler) ListHistory(ctx context.Context, req *notificationv1.ListHistoryRequest) (*notificationv1.ListHistoryResponse, error) {
+	filter := domain.HistoryFilter{
+		Recipient: req.GetRecipient(),
+		Template:  req.GetTemplate(),
+		Status:    domain.MessageStatus(req.GetStatus()),
+	}
+	if req.GetFrom() != nil {
+		filter.From = req.GetFrom().AsTime()
+	}
+	if req.GetTo() != nil {
+		filter.To = req.GetTo().AsTime()
+	}
+	messages, total, err := h.queue.History(ctx, filter, int(req.GetPageSize()), int(req.GetOffset()))
+	if err != nil {
+		return nil, mapQueueError(err)
+	}
+	out := make([]*notificationv1.QueuedMessage, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, queuedMessageToProto(m))
+	}
+	return &notificationv1.ListHistoryResponse{Messages: out, Total: total}, nil
+}
+
+func (h *NotificationHandler) RequeueDeadMessage(ctx context.Context, req *notificationv1.RequeueDeadMessageRequest) (*notificationv1.RequeueDeadMessageResponse, error) {
+	id, err := uuid.Parse(req.GetMessageId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid message_id")
+	}
+	if err := h.queue.Requeue(ctx, id); err != nil {
+		return nil, mapQueueError(err)
+	}
+	return &notificationv1.RequeueDeadMessageResponse{}, nil
+}
+
+func queuedMessageToProto(m *domain.Message) *notificationv1.QueuedMessage {
+	return &notificationv1.QueuedMessage{
+		Id:                m.ID.String(),
+		Channel:           m.Channel,
+		Recipient:         m.Recipient,
+		Template:          m.Template,
+		Locale:            m.Locale,
+		Status:            string(m.Status),
+		Attempts:          int32(m.Attempts),
+		LastError:         m.LastError,
+		NextAttemptAt:     timestampOrNil(m.NextAttemptAt),
+		CreatedAt:         timestampOrNil(m.CreatedAt),
+		ProviderMessageId: m.ProviderMessageID,
+	}
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func mapQueueError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrMessageNotFound):
+		return status.Error(codes.NotFound, "message not found")
+	case errors.Is(err, domain.ErrMessageNotCancelable):
+		return status.Error(codes.FailedPrecondition, "message is no longer cancelable")
+	default:
+		return status.Error(codes.Internal, "queue operation failed")
+	}
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+func (h *NotificationHandler) GetPreferences(ctx context.Context, req *notificationv1.GetPreferencesRequest) (*notificationv1.GetPreferencesResponse, error) {
+	if req.GetMobile() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mobile is required")
+	}
+	prefs, err := h.prefs.Get(ctx, req.GetMobile())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &notificationv1.GetPreferencesResponse{Preferences: preferencesToProto(prefs)}, nil
+}
+
+func (h *NotificationHandler) UpdatePreferences(ctx context.Context, req *notificationv1.UpdatePreferencesRequest) (*notificationv1.UpdatePreferencesResponse, error) {
+	if req.GetMobile() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mobile is required")
+	}
+	prefs := &domain.Preferences{
+		Mobile:  req.GetMobile(),
+		Locale:  req.GetLocale(),
+		OptOuts: map[string]bool{},
+	}
+	for _, key := range req.GetOptOuts() {
+		prefs.OptOuts[key] = true
+	}
+	if err := h.prefs.Update(ctx, prefs); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &notificationv1.UpdatePreferencesResponse{Preferences: preferencesToProto(prefs)}, nil
+}
+
+func preferencesToProto(p *domain.Preferences) *notificationv1.Preferences {
+	out := &notificationv1.Preferences{Mobile: p.Mobile, Locale: p.Locale}
+	for key, optedOut := range p.OptOuts {
+		if optedOut {
+			out.OptOuts = append(out.OptOuts, key)
+		}
+	}
+	return out
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+	"github.com/K-H-Tech/infera/services/notification/provider"
+)
+
+// WithSandbox exposes the sandbox driver's inbox through the test API.
+// Production deployments never call this, leaving the RPC unavailable.
+func (h *NotificationHandler) WithSandbox(sandbox *provider.Sandbox) *NotificationHandler {
+	h.sandbox = sandbox
+	return h
+}
+
+func (h *NotificationHandler) ListSandboxInbox(ctx context.Context, req *notificationv1.ListSandboxInboxRequest) (*notificationv1.ListSandboxInboxResponse, error) {
+	if h.sandbox == nil {
+		return nil, status.Error(codes.FailedPrecondition, "sandbox mode is not enabled")
+	}
+	resp := &notificationv1.ListSandboxInboxResponse{}
+	for _, msg := range h.sandbox.Inbox(req.GetRecipient()) {
+		resp.Messages = append(resp.Messages, &notificationv1.SandboxMessage{
+			Recipient: msg.Recipient,
+			Template:  msg.Template,
+			Params:    msg.Params,
+			Body:      msg.Body,
+			SentAt:    timestamppb.New(msg.SentAt),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+func (h *NotificationHandler) PublishTemplate(ctx context.Context, req *notificationv1.PublishTemplateRequest) (*notificationv1.PublishTemplateResponse, error) {
+	tpl, err := h.templates.Publish(ctx, &domain.Template{
+		Name:        req.GetName(),
+		Locale:      req.GetLocale(),
+		Body:        req.GetBody(),
+		ProviderRef: req.GetProviderRef(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &notificationv1.PublishTemplateResponse{Template: templateToProto(tpl)}, nil
+}
+
+func (h *NotificationHandler) ListTemplates(ctx context.Context, req *notificationv1.ListTemplatesRequest) (*notificationv1.ListTemplatesResponse, error) {
+	templates, err := h.templates.List(ctx, req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	resp := &notificationv1.ListTemplatesResponse{}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, templateToProto(t))
+	}
+	return resp, nil
+}
+
+func templateToProto(t *domain.Template) *notificationv1.Template {
+	return &notificationv1.Template{
+		Id:          t.ID.String(),
+		Name:        t.Name,
+		Locale:      t.Locale,
+		Version:     int32(t.Version),
+		Body:        t.Body,
+		ProviderRef: t.ProviderRef,
+		Active:      t.Active,
+	}
+}
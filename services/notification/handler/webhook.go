@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+func (h *NotificationHandler) CreateWebhookEndpoint(ctx context.Context, req *notificationv1.CreateWebhookEndpointRequest) (*notificationv1.CreateWebhookEndpointResponse, error) {
+	endpoint, err := h.webhooks.CreateEndpoint(ctx, req.GetCustomerId(), req.GetUrl(), req.GetEvents())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// The secret is returned exactly once, at creation.
+	return &notificationv1.CreateWebhookEndpointResponse{
+		Endpoint: webhookEndpointToProto(endpoint),
+		Secret:   endpoint.Secret,
+	}, nil
+}
+
+func (h *NotificationHandler) ListWebhookEndpoints(ctx context.Context, req *notificationv1.ListWebhookEndpointsRequest) (*notificationv1.ListWebhookEndpointsResponse, error) {
+	endpoints, err := h.webhooks.ListEndpoints(ctx, req.GetCustomerId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	resp := &notificationv1.ListWebhookEndpointsResponse{}
+	for _, e := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, webhookEndpointToProto(e))
+	}
+	return resp, nil
+}
+
+func (h *NotificationHandler) DeleteWebhookEndpoint(ctx context.Context, req *notificationv1.DeleteWebhookEndpointRequest) (*notificationv1.DeleteWebhookEndpointResponse, error) {
+	id, err := uuid.Parse(req.GetEndpointId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid endpoint_id")
+	}
+	if err := h.webhooks.DeleteEndpoint(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrWebhookEndpointNotFound) {
+			return nil, status.Error(codes.NotFound, "endpoint not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &notificationv1.DeleteWebhookEndpointResponse{}, nil
+}
+
+func (h *NotificationHandler) PublishWebhookEvent(ctx context.Context, req *notificationv1.PublishWebhookEventRequest) (*notificationv1.PublishWebhookEventResponse, error) {
+	if req.GetEvent() == "" {
+		return nil, status.Error(codes.InvalidArgument, "event is required")
+	}
+	enqueued, err := h.webhooks.PublishEvent(ctx, req.GetEvent(), req.GetData())
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	return &notificationv1.PublishWebhookEventResponse{Deliveries: int32(enqueued)}, nil
+}
+
+func webhookEndpointToProto(e *domain.WebhookEndpoint) *notificationv1.WebhookEndpoint {
+	return &notificationv1.WebhookEndpoint{
+		Id:         e.ID.String(),
+		CustomerId: e.CustomerID,
+		Url:        e.URL,
+		Events:     e.Events,
+		Active:     e.Active,
+	}
+}
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS notification_templates (
+    id            UUID PRIMARY KEY,
+    name          TEXT NOT NULL,
+    locale        TEXT NOT NULL,
+    version       INT NOT NULL,
+    body          TEXT NOT NULL DEFAULT '',
+    provider_ref  TEXT NOT NULL DEFAULT '',
+    active        BOOLEAN NOT NULL DEFAULT true,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (name, locale, version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_templates_lookup
+    ON notification_templates (name, locale) WHERE active;
+
+-- Seed the templates the auth service depends on. Bodies can be replaced
+-- through the template API; provider_ref entries use the provider's
+-- template mechanism instead of a local body.
+INSERT INTO notification_templates (id, name, locale, version, body, provider_ref)
+VALUES
+    (gen_random_uuid(), 'otp-login',      'fa', 1, '', 'infera-otp'),
+    (gen_random_uuid(), 'otp-login',      'en', 1, 'Your login code: {{.code}}', ''),
+    (gen_random_uuid(), 'mobile-changed', 'fa', 1, 'شماره همراه حساب شما به {{.token}} تغییر کرد.', ''),
+    (gen_random_uuid(), 'mobile-changed', 'en', 1, 'Your account mobile number was changed to {{.token}}.', '')
+ON CONFLICT DO NOTHING;
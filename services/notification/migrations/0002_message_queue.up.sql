@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS notification_messages (
+    id                  UUID PRIMARY KEY,
+    channel             TEXT        NOT NULL,
+    recipient           TEXT        NOT NULL,
+    template            TEXT        NOT NULL,
+    locale              TEXT        NOT NULL DEFAULT 'fa',
+    params              JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    status              TEXT        NOT NULL DEFAULT 'queued',
+    attempts            INT         NOT NULL DEFAULT 0,
+    next_attempt_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error          TEXT        NOT NULL DEFAULT '',
+    provider_message_id TEXT        NOT NULL DEFAULT '',
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Workers poll for due queued messages; the partial index keeps the poll
+-- cheap as sent rows accumulate.
+CREATE INDEX IF NOT EXISTS idx_notification_messages_due
+    ON notification_messages (next_attempt_at)
+    WHERE status = 'queued';
+
+CREATE INDEX IF NOT EXISTS idx_notification_messages_dead
+    ON notification_messages (updated_at DESC)
+    WHERE status = 'dead';
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    mobile     TEXT PRIMARY KEY,
+    locale     TEXT        NOT NULL DEFAULT '',
+    -- opt_outs maps "channel:category" to true for suppressed pairs.
+    opt_outs   JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE notification_messages
+    ADD COLUMN IF NOT EXISTS category TEXT NOT NULL DEFAULT 'transactional';
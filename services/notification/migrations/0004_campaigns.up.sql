@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS notification_campaigns (
+    id         UUID PRIMARY KEY,
+    name       TEXT        NOT NULL,
+    template   TEXT        NOT NULL,
+    locale     TEXT        NOT NULL DEFAULT 'fa',
+    category   TEXT        NOT NULL DEFAULT 'marketing',
+    params     JSONB       NOT NULL DEFAULT '{}'::jsonb,
+    total      INT         NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE notification_messages
+    ADD COLUMN IF NOT EXISTS campaign_id UUID;
+
+-- Progress queries group the campaign's messages by status.
+CREATE INDEX IF NOT EXISTS idx_notification_messages_campaign
+    ON notification_messages (campaign_id)
+    WHERE campaign_id IS NOT NULL;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id          UUID PRIMARY KEY,
+    customer_id TEXT        NOT NULL DEFAULT '',
+    url         TEXT        NOT NULL,
+    secret      TEXT        NOT NULL,
+    -- events lists the subscribed event names; empty means all events.
+    events      TEXT[]      NOT NULL DEFAULT '{}',
+    active      BOOLEAN     NOT NULL DEFAULT true,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_customer
+    ON webhook_endpoints (customer_id);
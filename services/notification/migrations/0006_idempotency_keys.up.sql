@@ -0,0 +1,8 @@
+ALTER TABLE notification_messages
+    ADD COLUMN IF NOT EXISTS idempotency_key TEXT NOT NULL DEFAULT '';
+
+-- A duplicate key makes the insert a no-op; the service then returns the
+-- original message. Empty keys (no idempotency requested) are exempt.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_messages_idempotency_key
+    ON notification_messages (idempotency_key)
+    WHERE idempotency_key <> '';
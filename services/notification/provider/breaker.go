@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"sync"
+	"time"
+)
+
+// ErrBreakerOpen is returned without calling the provider while its
+// breaker is open.
+var ErrBreakerOpen = errors.New("provider: circuit breaker open")
+
+// Breaker states, exported as the <driver>.breaker_state gauge.
+const (
+	breakerClosed int64 = iota
+	breakerHalfOpen
+	breakerOpen
+)
+
+// BreakerConfig tunes a provider circuit breaker.
+type BreakerConfig struct {
+	// FailureThreshold is how many consecutive failures open the breaker.
+	FailureThreshold int
+	// OpenTimeout is how long the breaker stays open before letting one
+	// half-open probe through.
+	OpenTimeout time.Duration
+}
+
+// DefaultBreakerConfig is used when the config section is absent.
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{FailureThreshold: 5, OpenTimeout: 30 * time.Second}
+}
+
+// Breaker wraps a driver with a circuit breaker: after consecutive
+// failures it fails fast, then periodically lets a single probe through
+// to detect recovery. The failover chain consults Healthy to skip broken
+// providers without consuming their probe budget.
+type Breaker struct {
+	next SMSProvider
+	cfg  BreakerConfig
+
+	mu       sync.Mutex
+	state    int64
+	failures int
+	openedAt time.Time
+	probing  bool
+
+	stateGauge *expvar.Int
+}
+
+// NewBreaker wraps the driver.
+func NewBreaker(next SMSProvider, cfg BreakerConfig) *Breaker {
+	gauge := new(expvar.Int)
+	smsMetrics.Set(next.Name()+".breaker_state", gauge)
+	return &Breaker{next: next, cfg: cfg, stateGauge: gauge}
+}
+
+func (b *Breaker) Name() string { return b.next.Name() }
+
+// Healthy reports whether a send attempt has a chance of going through;
+// it never consumes the half-open probe.
+func (b *Breaker) Healthy() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state != breakerOpen {
+		return true
+	}
+	return time.Since(b.openedAt) >= b.cfg.OpenTimeout
+}
+
+func (b *Breaker) SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error) {
+	if err := b.allow(); err != nil {
+		return "", err
+	}
+	id, err := b.next.SendTemplate(ctx, mobile, template, params)
+	b.record(err)
+	return id, err
+}
+
+func (b *Breaker) SendText(ctx context.Context, mobile, text string) (string, error) {
+	if err := b.allow(); err != nil {
+		return "", err
+	}
+	id, err := b.next.SendText(ctx, mobile, text)
+	b.record(err)
+	return id, err
+}
+
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case breakerClosed:
+		return nil
+	case breakerHalfOpen:
+		if b.probing {
+			return ErrBreakerOpen
+		}
+		b.probing = true
+		return nil
+	default: // open
+		if time.Since(b.openedAt) < b.cfg.OpenTimeout {
+			return ErrBreakerOpen
+		}
+		b.setState(breakerHalfOpen)
+		b.probing = true
+		return nil
+	}
+}
+
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if err == nil {
+		b.failures = 0
+		b.setState(breakerClosed)
+		return
+	}
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.openedAt = time.Now()
+		b.setState(breakerOpen)
+	}
+}
+
+// setState must be called with the mutex held.
+func (b *Breaker) setState(state int64) {
+	b.state = state
+	b.stateGauge.Set(state)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// healthChecker is implemented by wrappers (the circuit breaker) that can
+// predict whether a send attempt is worth making.
+type healthChecker interface {
+	Healthy() bool
+}
+
+// Failover tries providers in order until one accepts the message,
+// skipping providers whose circuit breaker reports them unhealthy. When
+// every provider is unhealthy it tries them all anyway — failing an OTP
+// without attempting delivery is worse than a wasted call.
+type Failover struct {
+	providers []SMSProvider
+}
+
+// NewFailover builds the chain; order is preference order.
+func NewFailover(providers ...SMSProvider) *Failover {
+	return &Failover{providers: providers}
+}
+
+func (f *Failover) Name() string { return "failover" }
+
+func (f *Failover) SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error) {
+	return f.send(ctx, func(p SMSProvider) (string, error) {
+		return p.SendTemplate(ctx, mobile, template, params)
+	})
+}
+
+func (f *Failover) SendText(ctx context.Context, mobile, text string) (string, error) {
+	return f.send(ctx, func(p SMSProvider) (string, error) {
+		return p.SendText(ctx, mobile, text)
+	})
+}
+
+func (f *Failover) send(ctx context.Context, attempt func(SMSProvider) (string, error)) (string, error) {
+	candidates := f.healthy()
+	if len(candidates) == 0 {
+		candidates = f.providers
+	}
+	var errs []error
+	for _, p := range candidates {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		id, err := attempt(p)
+		if err == nil {
+			return id, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+	}
+	return "", fmt.Errorf("provider: all drivers failed: %w", errors.Join(errs...))
+}
+
+func (f *Failover) healthy() []SMSProvider {
+	var out []SMSProvider
+	for _, p := range f.providers {
+		if hc, ok := p.(healthChecker); ok && !hc.Healthy() {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// Kavenegar delivers SMS through Kavenegar's verify-lookup API. The API
+// key lives in the URL path (per Kavenegar's scheme); the receptor and
+// tokens are query parameters.
+type Kavenegar struct {
+	apiKey string
+	http   *common.HTTPClient
+}
+
+// NewKavenegar builds the Kavenegar driver.
+func NewKavenegar(apiKey string, client *common.HTTPClient) *Kavenegar {
+	if client == nil {
+		client = common.NewHTTPClient(common.WithBaseURL("https://api.kavenegar.com"))
+	}
+	return &Kavenegar{apiKey: apiKey, http: client}
+}
+
+func (k *Kavenegar) Name() string { return "kavenegar" }
+
+// kavenegarResponse is the subset of Kavenegar's envelope we read.
+type kavenegarResponse struct {
+	Return struct {
+		Status  int    `json:"status"`
+		Message string `json:"message"`
+	} `json:"return"`
+	Entries []struct {
+		MessageID int64 `json:"messageid"`
+	} `json:"entries"`
+}
+
+// SendTemplate calls verify/lookup. Kavenegar templates accept up to three
+// positional tokens; params are read from "token", "token2", "token3".
+func (k *Kavenegar) SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error) {
+	path := fmt.Sprintf("/v1/%s/verify/lookup.json", url.PathEscape(k.apiKey))
+	opts := []common.RequestOption{
+		common.WithQuery("receptor", mobile),
+		common.WithQuery("template", template),
+	}
+	for _, token := range []string{"token", "token2", "token3"} {
+		if v, ok := params[token]; ok {
+			opts = append(opts, common.WithQuery(token, v))
+		}
+	}
+	resp, err := k.http.Get(ctx, path, opts...)
+	if err != nil {
+		return "", fmt.Errorf("kavenegar: %w", err)
+	}
+	body, err := common.ReadResponseBody(resp)
+	if err != nil {
+		return "", fmt.Errorf("kavenegar: %w", err)
+	}
+	var parsed kavenegarResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("kavenegar: decode response: %w", err)
+	}
+	if parsed.Return.Status != 200 {
+		return "", fmt.Errorf("kavenegar: status %d: %s", parsed.Return.Status, parsed.Return.Message)
+	}
+	if len(parsed.Entries) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%d", parsed.Entries[0].MessageID), nil
+}
+
+// SendText calls sms/send for messages rendered by the template subsystem.
+func (k *Kavenegar) SendText(ctx context.Context, mobile, text string) (string, error) {
+	path := fmt.Sprintf("/v1/%s/sms/send.json", url.PathEscape(k.apiKey))
+	resp, err := k.http.Get(ctx, path,
+		common.WithQuery("receptor", mobile),
+		common.WithQuery("message", text),
+	)
+	if err != nil {
+		return "", fmt.Errorf("kavenegar: %w", err)
+	}
+	body, err := common.ReadResponseBody(resp)
+	if err != nil {
+		return "", fmt.Errorf("kavenegar: %w", err)
+	}
+	var parsed kavenegarResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("kavenegar: decode response: %w", err)
+	}
+	if parsed.Return.Status != 200 {
+		return "", fmt.Errorf("kavenegar: status %d: %s", parsed.Return.Status, parsed.Return.Message)
+	}
+	if len(parsed.Entries) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("%d", parsed.Entries[0].MessageID), nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"expvar"
+)
+
+// smsMetrics exposes per-driver delivery counters under the
+// "notification_sms" expvar map: <driver>.sent, <driver>.failed, and
+// <driver>.segments (the unit SMS providers bill by), so cost per
+// provider can be tracked without waiting for the invoice.
+var smsMetrics = expvar.NewMap("notification_sms")
+
+// Instrumented wraps a driver with delivery and cost counters.
+type Instrumented struct {
+	next SMSProvider
+}
+
+// NewInstrumented wraps the driver.
+func NewInstrumented(next SMSProvider) *Instrumented {
+	return &Instrumented{next: next}
+}
+
+func (i *Instrumented) Name() string { return i.next.Name() }
+
+func (i *Instrumented) SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error) {
+	id, err := i.next.SendTemplate(ctx, mobile, template, params)
+	// Provider-side templates are short single-segment messages.
+	i.record(err, 1)
+	return id, err
+}
+
+func (i *Instrumented) SendText(ctx context.Context, mobile, text string) (string, error) {
+	id, err := i.next.SendText(ctx, mobile, text)
+	i.record(err, Segments(text))
+	return id, err
+}
+
+func (i *Instrumented) record(err error, segments int64) {
+	name := i.next.Name()
+	if err != nil {
+		smsMetrics.Add(name+".failed", 1)
+		return
+	}
+	smsMetrics.Add(name+".sent", 1)
+	smsMetrics.Add(name+".segments", segments)
+}
+
+// Segments estimates how many SMS segments a body costs. Persian text is
+// sent as UCS-2, which fits 70 characters per segment (67 when
+// concatenated); the conservative 67 is used for multi-segment bodies.
+func Segments(text string) int64 {
+	runes := len([]rune(text))
+	if runes <= 70 {
+		return 1
+	}
+	return int64((runes + 66) / 67)
+}
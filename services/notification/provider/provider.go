@@ -0,0 +1,70 @@
+// Package provider contains the delivery drivers the notification service
+// dispatches through.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// SMSProvider delivers a templated SMS. The template name is already the
+// provider-specific identifier; mapping from logical names happens in the
+// service layer.
+type SMSProvider interface {
+	// Name identifies the provider in logs, metrics, and history rows.
+	Name() string
+	// SendTemplate delivers the template to the mobile with the given
+	// parameters and returns the provider's message ID.
+	SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error)
+	// SendText delivers an already-rendered message body.
+	SendText(ctx context.Context, mobile, text string) (string, error)
+}
+
+// Config is the environment-level driver configuration; which fields
+// matter depends on the driver.
+type Config struct {
+	// APIKey authenticates with the provider.
+	APIKey string
+	// APISecret is the second credential for providers that use one
+	// (Twilio's auth token).
+	APISecret string
+	// From is the sender line or number for drivers that require one.
+	From string
+	// BaseURL overrides the provider's default endpoint, mainly for tests.
+	BaseURL string
+	// TemplateRefs remaps the template references stored in the database to
+	// this provider's identifiers, so switching providers does not require
+	// republishing every template.
+	TemplateRefs map[string]string
+}
+
+// mapTemplate applies the config's template remapping.
+func (c Config) mapTemplate(template string) string {
+	if mapped, ok := c.TemplateRefs[template]; ok {
+		return mapped
+	}
+	return template
+}
+
+// New builds the named driver from config. Driver names are what the
+// deployment config uses to pick a provider per environment.
+func New(name string, cfg Config) (SMSProvider, error) {
+	var client *common.HTTPClient
+	if cfg.BaseURL != "" {
+		client = common.NewHTTPClient(common.WithBaseURL(cfg.BaseURL))
+	}
+	switch name {
+	case "kavenegar":
+		return NewKavenegar(cfg.APIKey, client), nil
+	case "smsir":
+		return NewSMSIR(cfg, client), nil
+	case "twilio":
+		return NewTwilio(cfg, client), nil
+	case "sandbox":
+		return NewSandbox(nil), nil
+	default:
+		return nil, fmt.Errorf("provider: unknown sms driver %q", name)
+	}
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// sandboxInboxCap bounds the in-memory inbox; older entries are dropped.
+const sandboxInboxCap = 1000
+
+// SandboxMessage is one delivery captured by the sandbox driver.
+type SandboxMessage struct {
+	Recipient string
+	// Template is set for provider-side template sends; Body for rendered
+	// text.
+	Template string
+	Params   map[string]string
+	Body     string
+	SentAt   time.Time
+}
+
+// Sandbox short-circuits delivery for non-production environments: it
+// logs each message and keeps it in an in-memory inbox so integration
+// tests can assert on "sent" OTPs. Queue history is recorded as usual
+// because the dispatcher sees a successful send.
+type Sandbox struct {
+	log *slog.Logger
+
+	mu       sync.Mutex
+	messages []SandboxMessage
+	seq      int64
+}
+
+// NewSandbox builds the sandbox driver.
+func NewSandbox(log *slog.Logger) *Sandbox {
+	if log == nil {
+		log = slog.Default()
+	}
+	return &Sandbox{log: log}
+}
+
+func (s *Sandbox) Name() string { return "sandbox" }
+
+func (s *Sandbox) SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error) {
+	s.log.Info("sandbox: sms template send",
+		"recipient", mobile, "template", template, "params", params)
+	return s.capture(SandboxMessage{Recipient: mobile, Template: template, Params: params}), nil
+}
+
+func (s *Sandbox) SendText(ctx context.Context, mobile, text string) (string, error) {
+	s.log.Info("sandbox: sms text send", "recipient", mobile, "body", text)
+	return s.capture(SandboxMessage{Recipient: mobile, Body: text}), nil
+}
+
+func (s *Sandbox) capture(msg SandboxMessage) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msg.SentAt = time.Now()
+	s.messages = append(s.messages, msg)
+	if len(s.messages) > sandboxInboxCap {
+		s.messages = s.messages[len(s.messages)-sandboxInboxCap:]
+	}
+	s.seq++
+	return fmt.Sprintf("sandbox-%d", s.seq)
+}
+
+// Inbox returns captured messages, newest first, optionally filtered by
+// recipient.
+func (s *Sandbox) Inbox(recipient string) []SandboxMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []SandboxMessage
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if recipient != "" && s.messages[i].Recipient != recipient {
+			continue
+		}
+		out = append(out, s.messages[i])
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// SMSIR delivers SMS through the SMS.ir v1 API. Authentication is the
+// x-api-key header; verify templates are numeric IDs with named
+// parameters.
+type SMSIR struct {
+	cfg  Config
+	http *common.HTTPClient
+}
+
+// NewSMSIR builds the SMS.ir driver.
+func NewSMSIR(cfg Config, client *common.HTTPClient) *SMSIR {
+	if client == nil {
+		client = common.NewHTTPClient(common.WithBaseURL("https://api.sms.ir"))
+	}
+	return &SMSIR{cfg: cfg, http: client}
+}
+
+func (s *SMSIR) Name() string { return "smsir" }
+
+// smsirResponse is the subset of SMS.ir's envelope we read.
+type smsirResponse struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Data    struct {
+		MessageID int64 `json:"messageId"`
+	} `json:"data"`
+}
+
+// SendTemplate calls send/verify. The template reference must be SMS.ir's
+// numeric template ID, either directly or via the config's TemplateRefs
+// remapping; params become named template parameters.
+func (s *SMSIR) SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error) {
+	templateID, err := strconv.Atoi(s.cfg.mapTemplate(template))
+	if err != nil {
+		return "", fmt.Errorf("smsir: template ref %q is not a numeric template id", template)
+	}
+	type parameter struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	payload := struct {
+		Mobile     string      `json:"mobile"`
+		TemplateID int         `json:"templateId"`
+		Parameters []parameter `json:"parameters"`
+	}{Mobile: mobile, TemplateID: templateID}
+	for name, value := range params {
+		payload.Parameters = append(payload.Parameters, parameter{Name: name, Value: value})
+	}
+	return s.post(ctx, "/v1/send/verify", payload)
+}
+
+// SendText calls send/bulk with a single recipient, using the configured
+// sender line.
+func (s *SMSIR) SendText(ctx context.Context, mobile, text string) (string, error) {
+	payload := struct {
+		LineNumber  string   `json:"lineNumber"`
+		MessageText string   `json:"messageText"`
+		Mobiles     []string `json:"mobiles"`
+	}{LineNumber: s.cfg.From, MessageText: text, Mobiles: []string{mobile}}
+	return s.post(ctx, "/v1/send/bulk", payload)
+}
+
+func (s *SMSIR) post(ctx context.Context, path string, payload any) (string, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("smsir: encode request: %w", err)
+	}
+	resp, err := s.http.Post(ctx, path, "application/json", bytes.NewReader(body),
+		common.WithHeader("x-api-key", s.cfg.APIKey))
+	if err != nil {
+		return "", fmt.Errorf("smsir: %w", err)
+	}
+	respBody, err := common.ReadResponseBody(resp)
+	if err != nil {
+		return "", fmt.Errorf("smsir: %w", err)
+	}
+	var parsed smsirResponse
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("smsir: decode response: %w", err)
+	}
+	if parsed.Status != 1 {
+		return "", fmt.Errorf("smsir: status %d: %s", parsed.Status, parsed.Message)
+	}
+	return strconv.FormatInt(parsed.Data.MessageID, 10), nil
+}
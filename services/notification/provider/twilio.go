@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+)
+
+// Twilio delivers SMS through Twilio's Messages API, for deployments
+// outside the Iranian operators' reach. Twilio has no server-side verify
+// templates, so SendTemplate requires a TemplateRefs mapping to a local
+// body pattern with {{param}} placeholders.
+type Twilio struct {
+	cfg  Config
+	http *common.HTTPClient
+}
+
+// NewTwilio builds the Twilio driver. APIKey is the account SID and
+// APISecret the auth token.
+func NewTwilio(cfg Config, client *common.HTTPClient) *Twilio {
+	if client == nil {
+		client = common.NewHTTPClient(common.WithBaseURL("https://api.twilio.com"))
+	}
+	return &Twilio{cfg: cfg, http: client}
+}
+
+func (t *Twilio) Name() string { return "twilio" }
+
+// twilioResponse is the subset of Twilio's envelope we read.
+type twilioResponse struct {
+	SID     string `json:"sid"`
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+// SendTemplate substitutes params into the mapped body pattern and sends
+// the result as text.
+func (t *Twilio) SendTemplate(ctx context.Context, mobile, template string, params map[string]string) (string, error) {
+	pattern, ok := t.cfg.TemplateRefs[template]
+	if !ok {
+		return "", fmt.Errorf("twilio: no body mapping for template ref %q", template)
+	}
+	body := pattern
+	for name, value := range params {
+		body = strings.ReplaceAll(body, "{{"+name+"}}", value)
+	}
+	return t.SendText(ctx, mobile, body)
+}
+
+// SendText posts to the Messages endpoint with basic auth.
+func (t *Twilio) SendText(ctx context.Context, mobile, text string) (string, error) {
+	form := url.Values{}
+	form.Set("To", mobile)
+	form.Set("From", t.cfg.From)
+	form.Set("Body", text)
+
+	path := fmt.Sprintf("/2010-04-01/Accounts/%s/Messages.json", url.PathEscape(t.cfg.APIKey))
+	auth := base64.StdEncoding.EncodeToString([]byte(t.cfg.APIKey + ":" + t.cfg.APISecret))
+	resp, err := t.http.Post(ctx, path, "application/x-www-form-urlencoded",
+		strings.NewReader(form.Encode()),
+		common.WithHeader("Authorization", "Basic "+auth))
+	if err != nil {
+		return "", fmt.Errorf("twilio: %w", err)
+	}
+	respBody, readErr := common.ReadResponseBody(resp)
+	var parsed twilioResponse
+	if decodeErr := json.Unmarshal(respBody, &parsed); decodeErr == nil && parsed.Code != 0 {
+		return "", fmt.Errorf("twilio: error %d: %s", parsed.Code, parsed.Message)
+	}
+	if readErr != nil {
+		return "", fmt.Errorf("twilio: %w", readErr)
+	}
+	return parsed.SID, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// CampaignRepository is the Postgres implementation of
+// domain.CampaignRepository.
+type CampaignRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewCampaignRepository builds the repository over the given pool.
+func NewCampaignRepository(pool *pgxpool.Pool) *CampaignRepository {
+	return &CampaignRepository{pool: pool}
+}
+
+func (r *CampaignRepository) Create(ctx context.Context, c *domain.Campaign) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO notification_campaigns (id, name, template, locale, category, params, total, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, now())`,
+		c.ID, c.Name, c.Template, c.Locale, c.Category, c.Params, c.Total)
+	if err != nil {
+		return fmt.Errorf("insert notification_campaigns: %w", err)
+	}
+	return nil
+}
+
+func (r *CampaignRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Campaign, error) {
+	var c domain.Campaign
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, name, template, locale, category, params, total, created_at
+		FROM notification_campaigns WHERE id = $1`, id).
+		Scan(&c.ID, &c.Name, &c.Template, &c.Locale, &c.Category, &c.Params, &c.Total, &c.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrCampaignNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select notification_campaigns: %w", err)
+	}
+	return &c, nil
+}
+
+func (r *CampaignRepository) Progress(ctx context.Context, id uuid.UUID) (*domain.CampaignProgress, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT status, count(*) FROM notification_messages
+		WHERE campaign_id = $1 GROUP BY status`, id)
+	if err != nil {
+		return nil, fmt.Errorf("count campaign messages: %w", err)
+	}
+	defer rows.Close()
+
+	var p domain.CampaignProgress
+	for rows.Next() {
+		var (
+			status domain.MessageStatus
+			count  int64
+		)
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("scan campaign progress: %w", err)
+		}
+		switch status {
+		case domain.MessageQueued:
+			p.Queued = count
+		case domain.MessageSending:
+			p.Sending = count
+		case domain.MessageSent:
+			p.Sent = count
+		case domain.MessageDead:
+			p.Dead = count
+		case domain.MessageSuppressed:
+			p.Suppressed = count
+		case domain.MessageCanceled:
+			p.Canceled = count
+		}
+	}
+	return &p, rows.Err()
+}
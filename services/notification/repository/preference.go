@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// PreferenceRepository is the Postgres implementation of
+// domain.PreferenceRepository.
+type PreferenceRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPreferenceRepository builds the repository over the given pool.
+func NewPreferenceRepository(pool *pgxpool.Pool) *PreferenceRepository {
+	return &PreferenceRepository{pool: pool}
+}
+
+func (r *PreferenceRepository) Get(ctx context.Context, mobile string) (*domain.Preferences, error) {
+	var p domain.Preferences
+	err := r.pool.QueryRow(ctx,
+		`SELECT mobile, locale, opt_outs, updated_at
+		 FROM notification_preferences WHERE mobile = $1`, mobile).
+		Scan(&p.Mobile, &p.Locale, &p.OptOuts, &p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPreferencesNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select notification_preferences: %w", err)
+	}
+	if p.OptOuts == nil {
+		p.OptOuts = map[string]bool{}
+	}
+	return &p, nil
+}
+
+func (r *PreferenceRepository) Upsert(ctx context.Context, prefs *domain.Preferences) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO notification_preferences (mobile, locale, opt_outs, updated_at)
+		VALUES ($1, $2, $3, now())
+		ON CONFLICT (mobile) DO UPDATE
+		SET locale = EXCLUDED.locale, opt_outs = EXCLUDED.opt_outs, updated_at = now()`,
+		prefs.Mobile, prefs.Locale, prefs.OptOuts)
+	if err != nil {
+		return fmt.Errorf("upsert notification_preferences: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// MessageQueue is the Postgres implementation of domain.MessageQueue.
+type MessageQueue struct {
+	pool *pgxpool.Pool
+}
+
+// NewMessageQueue builds the queue over the given pool.
+func NewMessageQueue(pool *pgxpool.Pool) *MessageQueue {
+	return &MessageQueue{pool: pool}
+}
+
+const messageColumns = "id, channel, recipient, template, locale, category, campaign_id, idempotency_key, params, status, attempts, next_attempt_at, last_error, provider_message_id, created_at, updated_at"
+
+func (q *MessageQueue) Enqueue(ctx context.Context, msg *domain.Message) error {
+	const query = `
+		INSERT INTO notification_messages
+			(id, channel, recipient, template, locale, category, campaign_id, idempotency_key, params, status, attempts, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 0, $11, now(), now())
+		ON CONFLICT (idempotency_key) WHERE idempotency_key <> '' DO NOTHING`
+	tag, err := q.pool.Exec(ctx, query,
+		msg.ID, msg.Channel, msg.Recipient, msg.Template, msg.Locale, msg.Category, msg.CampaignID,
+		msg.IdempotencyKey, msg.Params, msg.Status, msg.NextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("insert notification_messages: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrDuplicateIdempotencyKey
+	}
+	return nil
+}
+
+func (q *MessageQueue) GetByIdempotencyKey(ctx context.Context, key string) (*domain.Message, error) {
+	row := q.pool.QueryRow(ctx,
+		"SELECT "+messageColumns+" FROM notification_messages WHERE idempotency_key = $1", key)
+	return scanMessage(row)
+}
+
+// ClaimDue uses FOR UPDATE SKIP LOCKED so concurrent workers never claim
+// the same message, mirroring the outbox relay.
+func (q *MessageQueue) ClaimDue(ctx context.Context, now time.Time, limit int) ([]*domain.Message, error) {
+	const query = `
+		UPDATE notification_messages SET status = 'sending', updated_at = now()
+		WHERE id IN (
+			SELECT id FROM notification_messages
+			WHERE status = 'queued' AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + messageColumns
+	rows, err := q.pool.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim notification_messages: %w", err)
+	}
+	defer rows.Close()
+	return scanMessages(rows)
+}
+
+func (q *MessageQueue) MarkSent(ctx context.Context, id uuid.UUID, providerMessageID string) error {
+	return q.mark(ctx, id, `
+		UPDATE notification_messages
+		SET status = 'sent', provider_message_id = $2, last_error = '', updated_at = now()
+		WHERE id = $1`, providerMessageID)
+}
+
+func (q *MessageQueue) MarkRetry(ctx context.Context, id uuid.UUID, nextAttemptAt time.Time, lastError string) error {
+	return q.mark(ctx, id, `
+		UPDATE notification_messages
+		SET status = 'queued', attempts = attempts + 1, next_attempt_at = $2, last_error = $3, updated_at = now()
+		WHERE id = $1`, nextAttemptAt, lastError)
+}
+
+func (q *MessageQueue) MarkDead(ctx context.Context, id uuid.UUID, lastError string) error {
+	return q.mark(ctx, id, `
+		UPDATE notification_messages
+		SET status = 'dead', attempts = attempts + 1, last_error = $2, updated_at = now()
+		WHERE id = $1`, lastError)
+}
+
+func (q *MessageQueue) Defer(ctx context.Context, id uuid.UUID, until time.Time) error {
+	return q.mark(ctx, id, `
+		UPDATE notification_messages
+		SET status = 'queued', next_attempt_at = $2, updated_at = now()
+		WHERE id = $1`, until)
+}
+
+func (q *MessageQueue) MarkSuppressed(ctx context.Context, id uuid.UUID, reason string) error {
+	return q.mark(ctx, id, `
+		UPDATE notification_messages
+		SET status = 'suppressed', last_error = $2, updated_at = now()
+		WHERE id = $1`, reason)
+}
+
+func (q *MessageQueue) GetByID(ctx context.Context, id uuid.UUID) (*domain.Message, error) {
+	row := q.pool.QueryRow(ctx,
+		"SELECT "+messageColumns+" FROM notification_messages WHERE id = $1", id)
+	return scanMessage(row)
+}
+
+func (q *MessageQueue) ListDead(ctx context.Context, limit, offset int) ([]*domain.Message, int64, error) {
+	var total int64
+	err := q.pool.QueryRow(ctx,
+		"SELECT count(*) FROM notification_messages WHERE status = 'dead'").Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count dead notification_messages: %w", err)
+	}
+	rows, err := q.pool.Query(ctx,
+		"SELECT "+messageColumns+` FROM notification_messages
+		 WHERE status = 'dead' ORDER BY updated_at DESC LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list dead notification_messages: %w", err)
+	}
+	defer rows.Close()
+	messages, err := scanMessages(rows)
+	return messages, total, err
+}
+
+// ListHistory pages messages matching the filter, newest first. The
+// WHERE clause is built dynamically the same way as the audit queries.
+func (q *MessageQueue) ListHistory(ctx context.Context, filter domain.HistoryFilter, limit, offset int) ([]*domain.Message, int64, error) {
+	where := "WHERE 1=1"
+	args := []any{}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.Recipient != "" {
+		where += " AND recipient = " + arg(filter.Recipient)
+	}
+	if filter.Template != "" {
+		where += " AND template = " + arg(filter.Template)
+	}
+	if filter.Status != "" {
+		where += " AND status = " + arg(string(filter.Status))
+	}
+	if !filter.From.IsZero() {
+		where += " AND created_at >= " + arg(filter.From)
+	}
+	if !filter.To.IsZero() {
+		where += " AND created_at < " + arg(filter.To)
+	}
+
+	var total int64
+	if err := q.pool.QueryRow(ctx,
+		"SELECT count(*) FROM notification_messages "+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("count notification_messages history: %w", err)
+	}
+
+	query := "SELECT " + messageColumns + " FROM notification_messages " + where +
+		" ORDER BY created_at DESC LIMIT " + arg(limit) + " OFFSET " + arg(offset)
+	rows, err := q.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list notification_messages history: %w", err)
+	}
+	defer rows.Close()
+	messages, err := scanMessages(rows)
+	return messages, total, err
+}
+
+func (q *MessageQueue) Requeue(ctx context.Context, id uuid.UUID) error {
+	return q.mark(ctx, id, `
+		UPDATE notification_messages
+		SET status = 'queued', attempts = 0, next_attempt_at = now(), updated_at = now()
+		WHERE id = $1 AND status = 'dead'`)
+}
+
+// DeliveredCountSince counts messages to the recipient that reached (or
+// are reaching) the provider since the given time, for the hourly cap.
+func (q *MessageQueue) DeliveredCountSince(ctx context.Context, recipient string, since time.Time) (int, error) {
+	var count int
+	err := q.pool.QueryRow(ctx, `
+		SELECT count(*) FROM notification_messages
+		WHERE recipient = $1 AND status IN ('sending', 'sent') AND updated_at >= $2`,
+		recipient, since).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count recent notification_messages: %w", err)
+	}
+	return count, nil
+}
+
+// DuplicateExists reports whether another message with the same recipient,
+// template, and params was dispatched since the given time.
+func (q *MessageQueue) DuplicateExists(ctx context.Context, msg *domain.Message, since time.Time) (bool, error) {
+	var exists bool
+	err := q.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM notification_messages
+			WHERE recipient = $1 AND template = $2 AND params = $3
+			  AND id <> $4 AND status IN ('sending', 'sent') AND updated_at >= $5
+		)`, msg.Recipient, msg.Template, msg.Params, msg.ID, since).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("check duplicate notification_messages: %w", err)
+	}
+	return exists, nil
+}
+
+func (q *MessageQueue) Cancel(ctx context.Context, id uuid.UUID) error {
+	tag, err := q.pool.Exec(ctx, `
+		UPDATE notification_messages
+		SET status = 'canceled', updated_at = now()
+		WHERE id = $1 AND status = 'queued'`, id)
+	if err != nil {
+		return fmt.Errorf("cancel notification_messages: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		// Distinguish "unknown" from "already dispatched" for the caller.
+		if _, getErr := q.GetByID(ctx, id); getErr != nil {
+			return getErr
+		}
+		return domain.ErrMessageNotCancelable
+	}
+	return nil
+}
+
+func (q *MessageQueue) mark(ctx context.Context, id uuid.UUID, query string, args ...any) error {
+	tag, err := q.pool.Exec(ctx, query, append([]any{id}, args...)...)
+	if err != nil {
+		return fmt.Errorf("update notification_messages: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrMessageNotFound
+	}
+	return nil
+}
+
+func scanMessages(rows pgx.Rows) ([]*domain.Message, error) {
+	var messages []*domain.Message
+	for rows.Next() {
+		m, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+	return messages, rows.Err()
+}
+
+func scanMessage(row pgx.Row) (*domain.Message, error) {
+	var m domain.Message
+	err := row.Scan(&m.ID, &m.Channel, &m.Recipient, &m.Template, &m.Locale, &m.Category, &m.CampaignID, &m.IdempotencyKey, &m.Params,
+		&m.Status, &m.Attempts, &m.NextAttemptAt, &m.LastError, &m.ProviderMessageID,
+		&m.CreatedAt, &m.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrMessageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select notification_messages: %w", err)
+	}
+	return &m, nil
+}
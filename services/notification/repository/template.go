@@ -0,0 +1,96 @@
+// Package repository contains the notification service's Postgres
+// repositories.
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// TemplateRepository is the Postgres implementation of
+// domain.TemplateRepository.
+type TemplateRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewTemplateRepository builds the repository over the given pool.
+func NewTemplateRepository(pool *pgxpool.Pool) *TemplateRepository {
+	return &TemplateRepository{pool: pool}
+}
+
+const templateColumns = "id, name, locale, version, body, provider_ref, active, created_at"
+
+func (r *TemplateRepository) GetActive(ctx context.Context, name, locale string) (*domain.Template, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+templateColumns+` FROM notification_templates
+		 WHERE name = $1 AND locale = $2 AND active
+		 ORDER BY version DESC LIMIT 1`, name, locale)
+	return scanTemplate(row)
+}
+
+func (r *TemplateRepository) Create(ctx context.Context, tpl *domain.Template) (*domain.Template, error) {
+	if tpl.ID == uuid.Nil {
+		tpl.ID = uuid.New()
+	}
+	// The version is assigned here so concurrent publishes cannot race to
+	// the same number.
+	const q = `
+		INSERT INTO notification_templates (id, name, locale, version, body, provider_ref, active, created_at)
+		VALUES ($1, $2, $3,
+			(SELECT COALESCE(MAX(version), 0) + 1 FROM notification_templates WHERE name = $2 AND locale = $3),
+			$4, $5, $6, now())
+		RETURNING ` + templateColumns
+	row := r.pool.QueryRow(ctx, q, tpl.ID, tpl.Name, tpl.Locale, tpl.Body, tpl.ProviderRef, tpl.Active)
+	return scanTemplate(row)
+}
+
+func (r *TemplateRepository) Deactivate(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx,
+		"UPDATE notification_templates SET active = false WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("deactivate notification_templates: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrTemplateNotFound
+	}
+	return nil
+}
+
+func (r *TemplateRepository) List(ctx context.Context, name string) ([]*domain.Template, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+templateColumns+` FROM notification_templates
+		 WHERE name = $1 ORDER BY locale, version DESC`, name)
+	if err != nil {
+		return nil, fmt.Errorf("list notification_templates: %w", err)
+	}
+	defer rows.Close()
+
+	var templates []*domain.Template
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, rows.Err()
+}
+
+func scanTemplate(row pgx.Row) (*domain.Template, error) {
+	var t domain.Template
+	err := row.Scan(&t.ID, &t.Name, &t.Locale, &t.Version, &t.Body, &t.ProviderRef, &t.Active, &t.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select notification_templates: %w", err)
+	}
+	return &t, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// WebhookEndpointRepository is the Postgres implementation of
+// domain.WebhookEndpointRepository.
+type WebhookEndpointRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewWebhookEndpointRepository builds the repository over the given pool.
+func NewWebhookEndpointRepository(pool *pgxpool.Pool) *WebhookEndpointRepository {
+	return &WebhookEndpointRepository{pool: pool}
+}
+
+const webhookColumns = "id, customer_id, url, secret, events, active, created_at"
+
+func (r *WebhookEndpointRepository) Create(ctx context.Context, e *domain.WebhookEndpoint) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO webhook_endpoints (id, customer_id, url, secret, events, active, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, now())`,
+		e.ID, e.CustomerID, e.URL, e.Secret, e.Events, e.Active)
+	if err != nil {
+		return fmt.Errorf("insert webhook_endpoints: %w", err)
+	}
+	return nil
+}
+
+func (r *WebhookEndpointRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookEndpoint, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+webhookColumns+" FROM webhook_endpoints WHERE id = $1", id)
+	return scanWebhookEndpoint(row)
+}
+
+func (r *WebhookEndpointRepository) ListActive(ctx context.Context) ([]*domain.WebhookEndpoint, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+webhookColumns+" FROM webhook_endpoints WHERE active ORDER BY created_at")
+	if err != nil {
+		return nil, fmt.Errorf("list webhook_endpoints: %w", err)
+	}
+	defer rows.Close()
+	return scanWebhookEndpoints(rows)
+}
+
+func (r *WebhookEndpointRepository) ListByCustomer(ctx context.Context, customerID string) ([]*domain.WebhookEndpoint, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+webhookColumns+" FROM webhook_endpoints WHERE customer_id = $1 ORDER BY created_at", customerID)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook_endpoints: %w", err)
+	}
+	defer rows.Close()
+	return scanWebhookEndpoints(rows)
+}
+
+func (r *WebhookEndpointRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM webhook_endpoints WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete webhook_endpoints: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrWebhookEndpointNotFound
+	}
+	return nil
+}
+
+func scanWebhookEndpoints(rows pgx.Rows) ([]*domain.WebhookEndpoint, error) {
+	var endpoints []*domain.WebhookEndpoint
+	for rows.Next() {
+		e, err := scanWebhookEndpoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints, rows.Err()
+}
+
+func scanWebhookEndpoint(row pgx.Row) (*domain.WebhookEndpoint, error) {
+	var e domain.WebhookEndpoint
+	err := row.Scan(&e.ID, &e.CustomerID, &e.URL, &e.Secret, &e.Events, &e.Active, &e.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrWebhookEndpointNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select webhook_endpoints: %w", err)
+	}
+	return &e, nil
+}
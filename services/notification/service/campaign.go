@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// campaignEnqueueBatch bounds how many recipients are fanned out per
+// queue insert round, keeping transactions short on large segments.
+const campaignEnqueueBatch = 500
+
+// CampaignService fans bulk sends out into the message queue, one message
+// per recipient, and reports progress from the queue's status counts.
+type CampaignService struct {
+	campaigns domain.CampaignRepository
+	queue     domain.MessageQueue
+	now       func() time.Time
+}
+
+// NewCampaignService builds the campaign service.
+func NewCampaignService(campaigns domain.CampaignRepository, queue domain.MessageQueue) *CampaignService {
+	return &CampaignService{campaigns: campaigns, queue: queue, now: time.Now}
+}
+
+// Start records the campaign and enqueues one message per recipient.
+// Campaign traffic defaults to the marketing category so recipient
+// opt-outs apply; delivery pacing is the dispatcher's job.
+func (s *CampaignService) Start(ctx context.Context, name, template, locale, category string, params map[string]string, recipients []string, scheduleAt time.Time) (*domain.Campaign, error) {
+	if name == "" || template == "" {
+		return nil, fmt.Errorf("notification: campaign name and template are required")
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("notification: campaign needs at least one recipient")
+	}
+	if category == "" {
+		category = domain.CategoryMarketing
+	}
+	due := s.now()
+	if scheduleAt.After(due) {
+		due = scheduleAt
+	}
+
+	campaign := &domain.Campaign{
+		ID:        uuid.New(),
+		Name:      name,
+		Template:  template,
+		Locale:    locale,
+		Category:  category,
+		Params:    params,
+		Total:     len(recipients),
+		CreatedAt: s.now(),
+	}
+	if err := s.campaigns.Create(ctx, campaign); err != nil {
+		return nil, fmt.Errorf("create campaign: %w", err)
+	}
+
+	for start := 0; start < len(recipients); start += campaignEnqueueBatch {
+		end := start + campaignEnqueueBatch
+		if end > len(recipients) {
+			end = len(recipients)
+		}
+		for _, recipient := range recipients[start:end] {
+			msg := &domain.Message{
+				ID:            uuid.New(),
+				Channel:       domain.ChannelSMS,
+				Recipient:     recipient,
+				Template:      template,
+				Locale:        locale,
+				Category:      category,
+				CampaignID:    uuid.NullUUID{UUID: campaign.ID, Valid: true},
+				Params:        params,
+				Status:        domain.MessageQueued,
+				NextAttemptAt: due,
+				CreatedAt:     s.now(),
+				UpdatedAt:     s.now(),
+			}
+			if err := s.queue.Enqueue(ctx, msg); err != nil {
+				return nil, fmt.Errorf("enqueue campaign message: %w", err)
+			}
+		}
+	}
+	return campaign, nil
+}
+
+// Progress returns the campaign and its per-status message counts.
+func (s *CampaignService) Progress(ctx context.Context, id uuid.UUID) (*domain.Campaign, *domain.CampaignProgress, error) {
+	campaign, err := s.campaigns.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	progress, err := s.campaigns.Progress(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return campaign, progress, nil
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"time"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// DispatcherConfig tunes the queue workers.
+type DispatcherConfig struct {
+	// PollInterval is how often idle workers check for due messages.
+	PollInterval time.Duration
+	// BatchSize bounds messages claimed per poll.
+	BatchSize int
+	// MaxAttempts before a message moves to the dead-letter queue.
+	MaxAttempts int
+	// BaseBackoff doubles per attempt, with jitter.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the backoff growth.
+	MaxBackoff time.Duration
+	// RatePerSecond paces provider calls to stay under the provider's
+	// limit; zero disables pacing. Campaign fan-outs depend on this so a
+	// bulk send does not starve or flood the provider.
+	RatePerSecond int
+}
+
+// DefaultDispatcherConfig is used when the config section is absent.
+func DefaultDispatcherConfig() DispatcherConfig {
+	return DispatcherConfig{
+		PollInterval: 500 * time.Millisecond,
+		BatchSize:    50,
+		MaxAttempts:  5,
+		BaseBackoff:  5 * time.Second,
+		MaxBackoff:   10 * time.Minute,
+	}
+}
+
+// Dispatcher drains the message queue: it renders and delivers claimed
+// messages, retries transient failures with exponential backoff, and moves
+// exhausted messages to the dead-letter queue.
+type Dispatcher struct {
+	queue    domain.MessageQueue
+	sender   *Sender
+	webhooks *WebhookService
+	prefs    *PreferenceService
+	guard    *DispatchGuard
+	windows  *SendWindowPolicy
+	cfg      DispatcherConfig
+	log      *slog.Logger
+	now      func() time.Time
+}
+
+// NewDispatcher builds a queue worker. webhooks and windows may be nil
+// when the deployment has no webhook channel or send-window policy.
+func NewDispatcher(queue domain.MessageQueue, sender *Sender, webhooks *WebhookService, prefs *PreferenceService, guard *DispatchGuard, windows *SendWindowPolicy, cfg DispatcherConfig, log *slog.Logger) *Dispatcher {
+	return &Dispatcher{queue: queue, sender: sender, webhooks: webhooks, prefs: prefs, guard: guard, windows: windows, cfg: cfg, log: log, now: time.Now}
+}
+
+// Run processes the queue until the context is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := d.DrainOnce(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				d.log.Error("dispatcher: drain failed", "error", err)
+			}
+		}
+	}
+}
+
+// DrainOnce claims and processes one batch. Exposed for tests and for the
+// sandbox CLI.
+func (d *Dispatcher) DrainOnce(ctx context.Context) error {
+	batch, err := d.queue.ClaimDue(ctx, d.now(), d.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+	var pace <-chan time.Time
+	if d.cfg.RatePerSecond > 0 {
+		ticker := time.NewTicker(time.Second / time.Duration(d.cfg.RatePerSecond))
+		defer ticker.Stop()
+		pace = ticker.C
+	}
+	for _, msg := range batch {
+		if pace != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-pace:
+			}
+		}
+		d.process(ctx, msg)
+	}
+	return nil
+}
+
+func (d *Dispatcher) process(ctx context.Context, msg *domain.Message) {
+	if msg.Channel == domain.ChannelWebhook {
+		d.processWebhook(ctx, msg)
+		return
+	}
+	if d.windows != nil {
+		if until := d.windows.DeferUntil(msg.Category, d.now()); !until.IsZero() {
+			d.log.Info("dispatcher: message deferred to send window",
+				"message_id", msg.ID, "category", msg.Category, "until", until)
+			if markErr := d.queue.Defer(ctx, msg.ID, until); markErr != nil {
+				d.log.Error("dispatcher: defer failed", "message_id", msg.ID, "error", markErr)
+			}
+			return
+		}
+	}
+	if d.guard != nil {
+		if allowed, reason := d.guard.Check(ctx, msg); !allowed {
+			d.log.Info("dispatcher: message suppressed by guard",
+				"message_id", msg.ID, "recipient", msg.Recipient, "reason", reason)
+			if markErr := d.queue.MarkSuppressed(ctx, msg.ID, reason); markErr != nil {
+				d.log.Error("dispatcher: mark suppressed failed", "message_id", msg.ID, "error", markErr)
+			}
+			return
+		}
+	}
+
+	// Preferences are checked at dispatch time, not enqueue time, so an
+	// opt-out saved after scheduling still takes effect.
+	locale := msg.Locale
+	if d.prefs != nil {
+		allowed, resolved := d.prefs.Resolve(ctx, msg)
+		if !allowed {
+			d.log.Info("dispatcher: message suppressed",
+				"message_id", msg.ID, "category", msg.Category)
+			if markErr := d.queue.MarkSuppressed(ctx, msg.ID, "recipient opted out of "+msg.Category); markErr != nil {
+				d.log.Error("dispatcher: mark suppressed failed", "message_id", msg.ID, "error", markErr)
+			}
+			return
+		}
+		locale = resolved
+	}
+
+	providerMessageID, err := d.sender.SendSMS(ctx, msg.Recipient, msg.Template, locale, msg.Params)
+	d.finish(ctx, msg, providerMessageID, err)
+}
+
+// processWebhook delivers a queued webhook; per-mobile guards and SMS
+// preferences do not apply to the channel.
+func (d *Dispatcher) processWebhook(ctx context.Context, msg *domain.Message) {
+	if d.webhooks == nil {
+		d.finish(ctx, msg, "", errors.New("webhook channel is not configured"))
+		return
+	}
+	d.finish(ctx, msg, "", d.webhooks.Deliver(ctx, msg))
+}
+
+// finish records the delivery outcome: sent, retry with backoff, or dead.
+func (d *Dispatcher) finish(ctx context.Context, msg *domain.Message, providerMessageID string, err error) {
+	if err == nil {
+		if markErr := d.queue.MarkSent(ctx, msg.ID, providerMessageID); markErr != nil {
+			d.log.Error("dispatcher: mark sent failed", "message_id", msg.ID, "error", markErr)
+		}
+		return
+	}
+
+	// Template errors are permanent: retrying cannot fix a missing
+	// template, so the message goes straight to the DLQ.
+	attempts := msg.Attempts + 1
+	if IsTemplateError(err) || attempts >= d.cfg.MaxAttempts {
+		d.log.Warn("dispatcher: message dead",
+			"message_id", msg.ID, "attempts", attempts, "error", err)
+		if markErr := d.queue.MarkDead(ctx, msg.ID, err.Error()); markErr != nil {
+			d.log.Error("dispatcher: mark dead failed", "message_id", msg.ID, "error", markErr)
+		}
+		return
+	}
+
+	next := d.now().Add(d.backoff(attempts))
+	d.log.Info("dispatcher: retry scheduled",
+		"message_id", msg.ID, "attempt", attempts, "next_attempt_at", next, "error", err)
+	if markErr := d.queue.MarkRetry(ctx, msg.ID, next, err.Error()); markErr != nil {
+		d.log.Error("dispatcher: mark retry failed", "message_id", msg.ID, "error", markErr)
+	}
+}
+
+// backoff returns base * 2^(attempt-1), capped, with up to 20% jitter so
+// retry waves from an outage do not land simultaneously.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	backoff := d.cfg.BaseBackoff << (attempt - 1)
+	if backoff > d.cfg.MaxBackoff || backoff <= 0 {
+		backoff = d.cfg.MaxBackoff
+	}
+	jitter := time.Duration(rand.Int63n(int64(backoff) / 5))
+	return backoff + jitter
+}
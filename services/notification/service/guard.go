@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// GuardConfig tunes the dispatch-time guards.
+type GuardConfig struct {
+	// MaxPerRecipientPerHour caps messages to one mobile per rolling hour;
+	// zero disables the cap.
+	MaxPerRecipientPerHour int
+	// DedupWindow suppresses identical messages (same recipient, template,
+	// params) dispatched within the window; zero disables dedup.
+	DedupWindow time.Duration
+}
+
+// DefaultGuardConfig is used when the config section is absent.
+func DefaultGuardConfig() GuardConfig {
+	return GuardConfig{
+		MaxPerRecipientPerHour: 20,
+		DedupWindow:            2 * time.Minute,
+	}
+}
+
+// DispatchGuard protects recipients from OTP storms caused by upstream
+// retries: it throttles per-mobile volume and drops identical messages
+// dispatched in quick succession. Store failures fail open — a guard
+// outage must never block OTP delivery.
+type DispatchGuard struct {
+	store domain.DispatchGuardStore
+	cfg   GuardConfig
+	log   *slog.Logger
+	now   func() time.Time
+}
+
+// NewDispatchGuard builds the guard.
+func NewDispatchGuard(store domain.DispatchGuardStore, cfg GuardConfig, log *slog.Logger) *DispatchGuard {
+	return &DispatchGuard{store: store, cfg: cfg, log: log, now: time.Now}
+}
+
+// Check reports whether the message may be dispatched; when it may not,
+// reason says why for the suppression record.
+func (g *DispatchGuard) Check(ctx context.Context, msg *domain.Message) (allowed bool, reason string) {
+	if g.cfg.MaxPerRecipientPerHour > 0 {
+		count, err := g.store.DeliveredCountSince(ctx, msg.Recipient, g.now().Add(-time.Hour))
+		if err != nil {
+			g.log.Warn("dispatch guard: count lookup failed, failing open", "error", err)
+		} else if count >= g.cfg.MaxPerRecipientPerHour {
+			return false, "recipient hourly message cap reached"
+		}
+	}
+	if g.cfg.DedupWindow > 0 {
+		dup, err := g.store.DuplicateExists(ctx, msg, g.now().Add(-g.cfg.DedupWindow))
+		if err != nil {
+			g.log.Warn("dispatch guard: dedup lookup failed, failing open", "error", err)
+		} else if dup {
+			return false, "duplicate of a recently dispatched message"
+		}
+	}
+	return true, ""
+}
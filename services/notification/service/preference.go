@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// PreferenceService manages recipient delivery preferences and answers
+// the dispatcher's "may this go out?" question.
+type PreferenceService struct {
+	repo domain.PreferenceRepository
+}
+
+// NewPreferenceService builds the preference service.
+func NewPreferenceService(repo domain.PreferenceRepository) *PreferenceService {
+	return &PreferenceService{repo: repo}
+}
+
+// Get returns the recipient's preferences, defaulting to deliver-everything
+// when none are saved.
+func (s *PreferenceService) Get(ctx context.Context, mobile string) (*domain.Preferences, error) {
+	prefs, err := s.repo.Get(ctx, mobile)
+	if errors.Is(err, domain.ErrPreferencesNotFound) {
+		return domain.DefaultPreferences(mobile), nil
+	}
+	return prefs, err
+}
+
+// Update replaces the recipient's preferences.
+func (s *PreferenceService) Update(ctx context.Context, prefs *domain.Preferences) error {
+	if prefs.OptOuts == nil {
+		prefs.OptOuts = map[string]bool{}
+	}
+	// Transactional opt-outs are silently discarded: OTPs and security
+	// alerts cannot be turned off.
+	for key := range prefs.OptOuts {
+		if key == domain.OptOutKey(domain.ChannelSMS, domain.CategoryTransactional) {
+			delete(prefs.OptOuts, key)
+		}
+	}
+	prefs.UpdatedAt = time.Now()
+	return s.repo.Upsert(ctx, prefs)
+}
+
+// Resolve applies preferences to an outgoing message: it reports whether
+// delivery is allowed and returns the locale to render with (the
+// recipient's override when set, otherwise the requested one). Lookup
+// failures fail open so a preferences outage never blocks OTPs.
+func (s *PreferenceService) Resolve(ctx context.Context, msg *domain.Message) (allowed bool, locale string) {
+	locale = msg.Locale
+	prefs, err := s.Get(ctx, msg.Recipient)
+	if err != nil {
+		return true, locale
+	}
+	if prefs.Locale != "" {
+		locale = prefs.Locale
+	}
+	return prefs.Allows(msg.Channel, msg.Category), locale
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// QueueService is the producer-side API over the dispatch queue: the send
+// RPCs enqueue here and return immediately.
+type QueueService struct {
+	queue domain.MessageQueue
+	now   func() time.Time
+}
+
+// NewQueueService builds the queue service.
+func NewQueueService(queue domain.MessageQueue) *QueueService {
+	return &QueueService{queue: queue, now: time.Now}
+}
+
+// EnqueueSMS stores an SMS for asynchronous dispatch and returns the
+// queued message. A future scheduleAt delays dispatch until that instant;
+// the zero time means "as soon as possible". A non-empty idempotencyKey
+// deduplicates client retries: resubmitting the same key returns the
+// original message with duplicate=true instead of sending again.
+func (s *QueueService) EnqueueSMS(ctx context.Context, recipient, template, locale, category, idempotencyKey string, params map[string]string, scheduleAt time.Time) (msg *domain.Message, duplicate bool, err error) {
+	if category == "" {
+		category = domain.CategoryTransactional
+	}
+	due := s.now()
+	if scheduleAt.After(due) {
+		due = scheduleAt
+	}
+	msg = &domain.Message{
+		ID:             uuid.New(),
+		Channel:        domain.ChannelSMS,
+		Recipient:      recipient,
+		Template:       template,
+		Locale:         locale,
+		Category:       category,
+		IdempotencyKey: idempotencyKey,
+		Params:         params,
+		Status:         domain.MessageQueued,
+		NextAttemptAt:  due,
+		CreatedAt:      s.now(),
+		UpdatedAt:      s.now(),
+	}
+	err = s.queue.Enqueue(ctx, msg)
+	if errors.Is(err, domain.ErrDuplicateIdempotencyKey) {
+		original, getErr := s.queue.GetByIdempotencyKey(ctx, idempotencyKey)
+		if getErr != nil {
+			return nil, false, fmt.Errorf("fetch original message for key: %w", getErr)
+		}
+		return original, true, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("enqueue message: %w", err)
+	}
+	return msg, false, nil
+}
+
+// Cancel withdraws a scheduled message that has not been dispatched.
+func (s *QueueService) Cancel(ctx context.Context, id uuid.UUID) error {
+	return s.queue.Cancel(ctx, id)
+}
+
+// ListDead pages through the dead-letter queue for the admin API.
+func (s *QueueService) ListDead(ctx context.Context, limit, offset int) ([]*domain.Message, int64, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return s.queue.ListDead(ctx, limit, offset)
+}
+
+// History pages outbound messages for support staff investigating
+// delivery complaints.
+func (s *QueueService) History(ctx context.Context, filter domain.HistoryFilter, limit, offset int) ([]*domain.Message, int64, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return s.queue.ListHistory(ctx, filter, limit, offset)
+}
+
+// Requeue returns a dead message to the queue.
+func (s *QueueService) Requeue(ctx context.Context, id uuid.UUID) error {
+	return s.queue.Requeue(ctx, id)
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+	"github.com/K-H-Tech/infera/services/notification/provider"
+)
+
+// ErrUnknownTemplate is kept as an alias for callers that matched on it
+// before templates moved into the database.
+var ErrUnknownTemplate = domain.ErrTemplateNotFound
+
+// DefaultLocale is assumed when a request carries no locale.
+const DefaultLocale = "fa"
+
+// Sender dispatches templated messages through the configured SMS
+// provider. Templates are resolved and rendered by the template subsystem;
+// callers only speak logical template names.
+type Sender struct {
+	sms       provider.SMSProvider
+	templates *TemplateService
+	log       *slog.Logger
+}
+
+// NewSender builds the dispatch service.
+func NewSender(sms provider.SMSProvider, templates *TemplateService, log *slog.Logger) *Sender {
+	return &Sender{sms: sms, templates: templates, log: log}
+}
+
+// SendOTP delivers a one-time password. The code travels as the "code"
+// parameter (and as "token" for provider-side templates).
+func (s *Sender) SendOTP(ctx context.Context, mobile, code, template, locale string) (string, error) {
+	return s.SendSMS(ctx, mobile, template, locale, map[string]string{"code": code, "token": code})
+}
+
+// SendSMS renders the template and delivers it.
+func (s *Sender) SendSMS(ctx context.Context, mobile, template, locale string, params map[string]string) (string, error) {
+	rendered, err := s.templates.Render(ctx, template, locale, params)
+	if err != nil {
+		return "", err
+	}
+	return s.deliver(ctx, mobile, rendered)
+}
+
+func (s *Sender) deliver(ctx context.Context, mobile string, rendered *RenderedMessage) (string, error) {
+	var (
+		messageID string
+		err       error
+	)
+	if rendered.Body != "" {
+		messageID, err = s.sms.SendText(ctx, mobile, rendered.Body)
+	} else {
+		messageID, err = s.sms.SendTemplate(ctx, mobile, rendered.ProviderRef, rendered.Params)
+	}
+	if err != nil {
+		return "", fmt.Errorf("send via %s: %w", s.sms.Name(), err)
+	}
+	return messageID, nil
+}
+
+// IsTemplateError reports whether the error came from template resolution
+// rather than delivery, so handlers can map it to InvalidArgument.
+func IsTemplateError(err error) bool {
+	return errors.Is(err, domain.ErrTemplateNotFound)
+}
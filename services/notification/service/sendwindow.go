@@ -0,0 +1,93 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// SendWindow is the daily interval in which a category may be dispatched,
+// in local wall-clock "15:04" form. From may be later than Until for
+// windows that span midnight.
+type SendWindow struct {
+	From  string
+	Until string
+}
+
+// SendWindowPolicy defers messages outside their category's send window
+// to the window's next opening. Transactional traffic always bypasses the
+// policy: an OTP at 03:00 was asked for at 03:00.
+type SendWindowPolicy struct {
+	windows map[string]parsedWindow
+	loc     *time.Location
+}
+
+type parsedWindow struct {
+	from  int // minutes since midnight
+	until int
+}
+
+// NewSendWindowPolicy parses the per-category windows; location is the
+// recipients' local time zone (Asia/Tehran in production).
+func NewSendWindowPolicy(windows map[string]SendWindow, loc *time.Location) (*SendWindowPolicy, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	parsed := make(map[string]parsedWindow, len(windows))
+	for category, w := range windows {
+		if category == domain.CategoryTransactional {
+			return nil, fmt.Errorf("notification: transactional traffic cannot have a send window")
+		}
+		from, err := parseClock(w.From)
+		if err != nil {
+			return nil, fmt.Errorf("notification: window for %s: %w", category, err)
+		}
+		until, err := parseClock(w.Until)
+		if err != nil {
+			return nil, fmt.Errorf("notification: window for %s: %w", category, err)
+		}
+		parsed[category] = parsedWindow{from: from, until: until}
+	}
+	return &SendWindowPolicy{windows: parsed, loc: loc}, nil
+}
+
+// DeferUntil returns the zero time when the message may go out now, or
+// the next instant its category's window opens.
+func (p *SendWindowPolicy) DeferUntil(category string, now time.Time) time.Time {
+	if category == "" || category == domain.CategoryTransactional {
+		return time.Time{}
+	}
+	w, ok := p.windows[category]
+	if !ok {
+		return time.Time{}
+	}
+
+	local := now.In(p.loc)
+	minute := local.Hour()*60 + local.Minute()
+	if w.inside(minute) {
+		return time.Time{}
+	}
+
+	opening := time.Date(local.Year(), local.Month(), local.Day(), w.from/60, w.from%60, 0, 0, p.loc)
+	if !opening.After(local) {
+		opening = opening.AddDate(0, 0, 1)
+	}
+	return opening
+}
+
+func (w parsedWindow) inside(minute int) bool {
+	if w.from <= w.until {
+		return minute >= w.from && minute < w.until
+	}
+	// Window spans midnight.
+	return minute >= w.from || minute < w.until
+}
+
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("bad clock time %q", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/K-H-Tech/infera/services/notification/domain"
+)
+
+// RenderedMessage is the outcome of template resolution: either a rendered
+// body to send as raw text, or a provider-side template reference with the
+// parameters to pass through.
+type RenderedMessage struct {
+	// Body is the rendered text; empty when the provider renders.
+	Body string
+	// ProviderRef is the provider-side template id; empty when Body is
+	// rendered locally.
+	ProviderRef string
+	Params      map[string]string
+	// TemplateID and Version record exactly which template produced the
+	// message, for history rows.
+	TemplateID string
+	Version    int
+}
+
+// TemplateService resolves and renders templates with locale fallback.
+type TemplateService struct {
+	repo domain.TemplateRepository
+}
+
+// NewTemplateService builds the template service.
+func NewTemplateService(repo domain.TemplateRepository) *TemplateService {
+	return &TemplateService{repo: repo}
+}
+
+// Render resolves (name, locale) — falling back to the default locale —
+// and substitutes params into the body.
+func (s *TemplateService) Render(ctx context.Context, name, locale string, params map[string]string) (*RenderedMessage, error) {
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	tpl, err := s.repo.GetActive(ctx, name, locale)
+	if errors.Is(err, domain.ErrTemplateNotFound) && locale != DefaultLocale {
+		tpl, err = s.repo.GetActive(ctx, name, DefaultLocale)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &RenderedMessage{
+		ProviderRef: tpl.ProviderRef,
+		Params:      params,
+		TemplateID:  tpl.ID.String(),
+		Version:     tpl.Version,
+	}
+	if tpl.Body == "" {
+		return msg, nil
+	}
+	parsed, err := template.New(tpl.Name).Option("missingkey=error").Parse(tpl.Body)
+	if err != nil {
+		return nil, fmt.Errorf("parse template %s/%s v%d: %w", name, locale, tpl.Version, err)
+	}
+	var buf bytes.Buffer
+	if err := parsed.Execute(&buf, params); err != nil {
+		return nil, fmt.Errorf("render template %s/%s v%d: %w", name, locale, tpl.Version, err)
+	}
+	msg.Body = buf.String()
+	return msg, nil
+}
+
+// Publish stores a new version of (name, locale) and activates it.
+func (s *TemplateService) Publish(ctx context.Context, tpl *domain.Template) (*domain.Template, error) {
+	if tpl.Name == "" || tpl.Locale == "" {
+		return nil, fmt.Errorf("notification: template name and locale are required")
+	}
+	if tpl.Body == "" && tpl.ProviderRef == "" {
+		return nil, fmt.Errorf("notification: template needs a body or a provider_ref")
+	}
+	// Validate the body before it can break sends at runtime.
+	if tpl.Body != "" {
+		if _, err := template.New(tpl.Name).Parse(tpl.Body); err != nil {
+			return nil, fmt.Errorf("notification: invalid template body: %w", err)
+		}
+	}
+	tpl.Active = true
+	return s.repo.Create(ctx, tpl)
+}
+
+// List returns every version of the named template across locales.
+func (s *TemplateService) List(ctx context.Context, name string) ([]*domain.Template, error) {
+	return s.repo.List(ctx, name)
+}
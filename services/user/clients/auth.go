@@ -0,0 +1,96 @@
+// Package clients wraps the gRPC clients the user service calls out to.
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	authv1 "github.com/K-H-Tech/infera/proto/gen/go/auth/v1"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Auth implements domain.SessionDirectory and
+// domain.LoginAuditDirectory over the auth service's gRPC API. The
+// caller's identity travels in the context metadata.
+type Auth struct {
+	client     authv1.AuthServiceClient
+	backoffice authv1.AuthBackofficeServiceClient
+}
+
+// NewAuth builds the client.
+func NewAuth(conn grpc.ClientConnInterface) *Auth {
+	return &Auth{
+		client:     authv1.NewAuthServiceClient(conn),
+		backoffice: authv1.NewAuthBackofficeServiceClient(conn),
+	}
+}
+
+// LoginSummary condenses the caller's login history: total event count
+// plus when and from where the latest login happened.
+func (a *Auth) LoginSummary(ctx context.Context) (domain.LoginSummary, error) {
+	resp, err := a.client.ListMyLoginActivity(ctx, &authv1.ListMyLoginActivityRequest{PageSize: 1})
+	if err != nil {
+		return domain.LoginSummary{}, fmt.Errorf("auth ListMyLoginActivity: %w", err)
+	}
+	return summarize(resp), nil
+}
+
+// UserLoginSummary is the backoffice variant of LoginSummary, reading
+// another user's history through the audit API.
+func (a *Auth) UserLoginSummary(ctx context.Context, userID uuid.UUID) (domain.LoginSummary, error) {
+	resp, err := a.backoffice.ListLoginAudit(ctx, &authv1.ListLoginAuditRequest{
+		UserId:   userID.String(),
+		PageSize: 1,
+	})
+	if err != nil {
+		return domain.LoginSummary{}, fmt.Errorf("auth ListLoginAudit: %w", err)
+	}
+	return summarize(resp), nil
+}
+
+// FindUserByMobile resolves a mobile number through the audit trail;
+// auth owns mobile numbers, so this is the only place to ask.
+func (a *Auth) FindUserByMobile(ctx context.Context, mobile string) (uuid.UUID, bool, error) {
+	resp, err := a.backoffice.ListLoginAudit(ctx, &authv1.ListLoginAuditRequest{
+		Mobile:   mobile,
+		PageSize: 1,
+	})
+	if err != nil {
+		return uuid.Nil, false, fmt.Errorf("auth ListLoginAudit: %w", err)
+	}
+	events := resp.GetEvents()
+	if len(events) == 0 {
+		return uuid.Nil, false, nil
+	}
+	userID, err := uuid.Parse(events[0].GetUserId())
+	if err != nil {
+		return uuid.Nil, false, nil
+	}
+	return userID, true, nil
+}
+
+// RevokeSessions force-expires all of the user's sessions, so a block
+// bites immediately instead of when the tokens run out.
+func (a *Auth) RevokeSessions(ctx context.Context, userID uuid.UUID) error {
+	_, err := a.backoffice.ForceSessionExpiry(ctx, &authv1.ForceSessionExpiryRequest{
+		UserId: userID.String(),
+		Reason: "user blocked",
+	})
+	if err != nil {
+		return fmt.Errorf("auth ForceSessionExpiry: %w", err)
+	}
+	return nil
+}
+
+func summarize(resp *authv1.ListLoginAuditResponse) domain.LoginSummary {
+	summary := domain.LoginSummary{Total: resp.GetTotal()}
+	if events := resp.GetEvents(); len(events) > 0 {
+		last := events[0].GetCreatedAt().AsTime()
+		summary.LastAt = &last
+		summary.LastIP = events[0].GetIp()
+	}
+	return summary
+}
@@ -0,0 +1,71 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+
+	customerv1 "github.com/K-H-Tech/infera/proto/gen/go/customer/v1"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Customer implements domain.BusinessDirectory and
+// domain.BusinessReassigner over the customer service's gRPC API.
+type Customer struct {
+	client customerv1.CustomerServiceClient
+}
+
+// NewCustomer builds the client.
+func NewCustomer(conn grpc.ClientConnInterface) *Customer {
+	return &Customer{client: customerv1.NewCustomerServiceClient(conn)}
+}
+
+// MyBusinesses returns the caller's businesses, reduced to the fields
+// the dashboard shows.
+func (c *Customer) MyBusinesses(ctx context.Context) ([]domain.BusinessSummary, error) {
+	resp, err := c.client.GetMyBusinesses(ctx, &customerv1.GetMyBusinessesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("customer GetMyBusinesses: %w", err)
+	}
+	return summarizeBusinesses(resp.GetBusinesses()), nil
+}
+
+// BusinessesOf lists another user's businesses through the customer
+// service's backoffice search.
+func (c *Customer) BusinessesOf(ctx context.Context, userID uuid.UUID) ([]domain.BusinessSummary, error) {
+	resp, err := c.client.SearchOnlineBusinesses(ctx, &customerv1.SearchOnlineBusinessesRequest{
+		UserId: userID.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("customer SearchOnlineBusinesses: %w", err)
+	}
+	return summarizeBusinesses(resp.GetBusinesses()), nil
+}
+
+// ReassignBusinesses moves every business of one owner to another
+// through the customer service's backoffice API, for account merges.
+func (c *Customer) ReassignBusinesses(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error) {
+	resp, err := c.client.ReassignBusinessOwner(ctx, &customerv1.ReassignBusinessOwnerRequest{
+		FromUserId: fromUserID.String(),
+		ToUserId:   toUserID.String(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("customer ReassignBusinessOwner: %w", err)
+	}
+	return resp.GetMoved(), nil
+}
+
+func summarizeBusinesses(businesses []*customerv1.OnlineBusiness) []domain.BusinessSummary {
+	summaries := make([]domain.BusinessSummary, 0, len(businesses))
+	for _, b := range businesses {
+		summaries = append(summaries, domain.BusinessSummary{
+			ID:          b.GetId(),
+			URL:         b.GetUrl(),
+			WebsiteName: b.GetWebsiteName(),
+			Status:      b.GetStatus(),
+		})
+	}
+	return summaries
+}
@@ -0,0 +1,72 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	notificationv1 "github.com/K-H-Tech/infera/proto/gen/go/notification/v1"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// exportReadyTemplate is the notification template announcing a
+// finished data export.
+const exportReadyTemplate = "user_export_ready"
+
+// Notification implements domain.LocaleSyncer, domain.PreferenceDirectory,
+// and domain.ExportNotifier over the notification service's gRPC API.
+type Notification struct {
+	client notificationv1.NotificationServiceClient
+}
+
+// NewNotification builds the client.
+func NewNotification(conn grpc.ClientConnInterface) *Notification {
+	return &Notification{client: notificationv1.NewNotificationServiceClient(conn)}
+}
+
+// SyncLocale writes the user's language into their notification
+// preferences so rendering follows it. Existing opt-outs are preserved
+// by reading the preferences back first.
+func (c *Notification) SyncLocale(ctx context.Context, mobile, locale string) error {
+	prefs, err := c.client.GetPreferences(ctx, &notificationv1.GetPreferencesRequest{Mobile: mobile})
+	if err != nil {
+		return fmt.Errorf("notification GetPreferences: %w", err)
+	}
+	_, err = c.client.UpdatePreferences(ctx, &notificationv1.UpdatePreferencesRequest{
+		Mobile:  mobile,
+		Locale:  locale,
+		OptOuts: prefs.GetPreferences().GetOptOuts(),
+	})
+	if err != nil {
+		return fmt.Errorf("notification UpdatePreferences: %w", err)
+	}
+	return nil
+}
+
+// PreferencesOf reads a user's notification preferences for inclusion
+// in data exports.
+func (c *Notification) PreferencesOf(ctx context.Context, mobile string) (*domain.NotificationPreferences, error) {
+	resp, err := c.client.GetPreferences(ctx, &notificationv1.GetPreferencesRequest{Mobile: mobile})
+	if err != nil {
+		return nil, fmt.Errorf("notification GetPreferences: %w", err)
+	}
+	prefs := resp.GetPreferences()
+	return &domain.NotificationPreferences{
+		Locale:  prefs.GetLocale(),
+		OptOuts: prefs.GetOptOuts(),
+	}, nil
+}
+
+// NotifyExportReady tells the user by SMS that their data archive is
+// ready for download.
+func (c *Notification) NotifyExportReady(ctx context.Context, mobile string) error {
+	_, err := c.client.SendSMS(ctx, &notificationv1.SendSMSRequest{
+		Mobile:   mobile,
+		Template: exportReadyTemplate,
+	})
+	if err != nil {
+		return fmt.Errorf("notification SendSMS: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrAddressNotFound is returned for unknown address IDs.
+	ErrAddressNotFound = errors.New("user: address not found")
+
+	// ErrInvalidPostalCode is returned when a postal code fails format
+	// checks.
+	ErrInvalidPostalCode = errors.New("user: invalid postal code")
+
+	// ErrPostalCodeUnknown is returned when the postal registry has no
+	// record for the code.
+	ErrPostalCodeUnknown = errors.New("user: postal code not found in the registry")
+
+	// ErrTooManyAddresses bounds the address book.
+	ErrTooManyAddresses = errors.New("user: address limit reached")
+)
+
+// Address is one entry in a user's address book. Province and city are
+// normalized from the postal registry when a lookup provider is
+// configured; the user supplies the street-level detail.
+type Address struct {
+	ID       uuid.UUID
+	UserID   uuid.UUID
+	Label    string
+	Province string
+	City     string
+	Street   string
+	// Details holds unit, floor, plate — the free-text remainder.
+	Details    string
+	PostalCode string
+	// Primary marks the address other services (customer business info)
+	// default to. Exactly one address per user is primary.
+	Primary bool
+	// Verified means province and city came from the postal registry,
+	// not from user input.
+	Verified  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PostalRecord is the registry's answer for one postal code.
+type PostalRecord struct {
+	Province string
+	City     string
+	Street   string
+}
+
+// PostalLookup resolves a postal code against the national registry.
+// Unknown codes return ErrPostalCodeUnknown.
+type PostalLookup interface {
+	Lookup(ctx context.Context, postalCode string) (PostalRecord, error)
+}
+
+// AddressRepository persists user addresses.
+type AddressRepository interface {
+	Create(ctx context.Context, a *Address) error
+	// GetByID returns the address, or ErrAddressNotFound.
+	GetByID(ctx context.Context, id uuid.UUID) (*Address, error)
+	// ListByUser returns the user's addresses, primary first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*Address, error)
+	// CountByUser returns how many addresses the user has.
+	CountByUser(ctx context.Context, userID uuid.UUID) (int, error)
+	Update(ctx context.Context, a *Address) (*Address, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// SetPrimary makes the address primary and demotes the previous one
+	// atomically.
+	SetPrimary(ctx context.Context, userID, id uuid.UUID) error
+}
+
+// ValidatePostalCode checks a 10-digit Iranian postal code.
+func ValidatePostalCode(code string) bool {
+	if len(code) != 10 || code[0] == '0' {
+		return false
+	}
+	same := true
+	for i := 0; i < len(code); i++ {
+		if code[i] < '0' || code[i] > '9' {
+			return false
+		}
+		if code[i] != code[0] {
+			same = false
+		}
+	}
+	return !same
+}
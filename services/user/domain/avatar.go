@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrUnsupportedImageType is returned for avatar content types the
+	// service does not accept.
+	ErrUnsupportedImageType = errors.New("user: unsupported image type")
+
+	// ErrImageTooLarge is returned when the declared avatar size exceeds
+	// the cap.
+	ErrImageTooLarge = errors.New("user: image too large")
+
+	// ErrInvalidUpload is returned when a confirm references an upload
+	// the caller never requested.
+	ErrInvalidUpload = errors.New("user: invalid upload")
+)
+
+// ObjectInfo is what the store reports about an uploaded object.
+type ObjectInfo struct {
+	ContentType string
+	SizeBytes   int64
+}
+
+// ObjectStore issues presigned URLs for direct-to-storage transfers,
+// so object bytes never pass through the service unnecessarily.
+type ObjectStore interface {
+	PresignPut(key, contentType string, expiry time.Duration) (string, error)
+	// PresignGet returns a time-limited download URL for the object.
+	PresignGet(key string, expiry time.Duration) (string, error)
+	// Stat reports the stored object's actual size and content type,
+	// or ErrInvalidUpload when nothing was uploaded under key.
+	Stat(ctx context.Context, key string) (ObjectInfo, error)
+}
+
+// Thumbnailer renders a fixed-size thumbnail of a stored image into a
+// new object. Implementations wrap whatever image pipeline the
+// deployment runs (imgproxy, a lambda, a worker).
+type Thumbnailer interface {
+	Thumbnail(ctx context.Context, srcKey, dstKey string) error
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Block reason codes. Free-text detail goes in the audit note; the code
+// is what reporting and appeal flows key on.
+const (
+	BlockReasonFraud      = "fraud"
+	BlockReasonChargeback = "chargeback"
+	BlockReasonLegal      = "legal_order"
+	BlockReasonAbuse      = "abuse"
+	// BlockReasonMerged marks the losing account of a backoffice merge;
+	// its data lives on under the surviving account.
+	BlockReasonMerged = "merged"
+	BlockReasonOther  = "other"
+)
+
+// ValidBlockReason reports whether code is a known reason code.
+func ValidBlockReason(code string) bool {
+	switch code {
+	case BlockReasonFraud, BlockReasonChargeback, BlockReasonLegal, BlockReasonAbuse,
+		BlockReasonMerged, BlockReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	// ErrInvalidBlockReason is returned for unknown reason codes.
+	ErrInvalidBlockReason = errors.New("user: invalid block reason")
+
+	// ErrAlreadyBlocked is returned when blocking a blocked user.
+	ErrAlreadyBlocked = errors.New("user: already blocked")
+
+	// ErrNotBlocked is returned when unblocking a user who is not
+	// blocked.
+	ErrNotBlocked = errors.New("user: not blocked")
+)
+
+// BlockEvent is one audit record of a block or unblock action.
+type BlockEvent struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Action is "block" or "unblock".
+	Action string
+	Reason string
+	Note   string
+	// ActorID is the operator who acted.
+	ActorID   uuid.UUID
+	CreatedAt time.Time
+}
+
+// BlockAuditRepository persists the block/unblock audit trail.
+type BlockAuditRepository interface {
+	Record(ctx context.Context, e *BlockEvent) error
+	// ListByUser returns the user's audit trail, newest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*BlockEvent, error)
+}
+
+// SessionRevoker force-expires a user's sessions in the auth service,
+// so a block takes effect before the access tokens would have expired.
+type SessionRevoker interface {
+	RevokeSessions(ctx context.Context, userID uuid.UUID) error
+}
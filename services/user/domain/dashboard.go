@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoginSummary condenses the user's login history for the dashboard.
+type LoginSummary struct {
+	Total  int64
+	LastAt *time.Time
+	LastIP string
+}
+
+// BusinessSummary is the dashboard's view of one online business.
+type BusinessSummary struct {
+	ID          string
+	URL         string
+	WebsiteName string
+	Status      string
+}
+
+// SessionDirectory reads the caller's login history from the auth
+// service.
+type SessionDirectory interface {
+	LoginSummary(ctx context.Context) (LoginSummary, error)
+}
+
+// BusinessDirectory reads the caller's businesses from the customer
+// service.
+type BusinessDirectory interface {
+	MyBusinesses(ctx context.Context) ([]BusinessSummary, error)
+	// BusinessesOf lists another user's businesses (backoffice paths).
+	BusinessesOf(ctx context.Context, userID uuid.UUID) ([]BusinessSummary, error)
+}
+
+// LoginAuditDirectory reads other users' login history from the auth
+// service's backoffice API.
+type LoginAuditDirectory interface {
+	// UserLoginSummary condenses one user's login history.
+	UserLoginSummary(ctx context.Context, userID uuid.UUID) (LoginSummary, error)
+	// FindUserByMobile resolves a mobile number to the user it belongs
+	// to; ok is false for unknown numbers.
+	FindUserByMobile(ctx context.Context, mobile string) (uuid.UUID, bool, error)
+}
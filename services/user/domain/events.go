@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User domain event names. The customer service gates business creation
+// on KYC changes; analytics keys consumers on these.
+const (
+	// EventProfileUpdated carries the changed field names, never the
+	// values — profile data is PII and stays out of the broker.
+	EventProfileUpdated  = "profile.updated"
+	EventKYCLevelChanged = "kyc.level_changed"
+	// EventIdentityVerified marks a successful civil-registry match.
+	EventIdentityVerified = "identity.verified"
+	// EventSettingsUpdated carries the changed setting keys.
+	EventSettingsUpdated = "settings.updated"
+	// EventUserBlocked and EventUserUnblocked let sibling services
+	// (auth token issuance, customer gating) react to risk actions.
+	EventUserBlocked   = "user.blocked"
+	EventUserUnblocked = "user.unblocked"
+	// EventUsersMerged announces an account merge; emitted for the
+	// surviving account.
+	EventUsersMerged = "user.merged"
+)
+
+// Event is one user domain event handed to the publisher.
+type Event struct {
+	Name       string
+	UserID     uuid.UUID
+	OccurredAt time.Time
+	Payload    map[string]string
+}
+
+// EventPublisher emits user domain events for other services to consume
+// asynchronously. Implementations go through the transactional outbox
+// so events are never lost for committed writes.
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportStatus is the lifecycle state of a data export.
+type ExportStatus string
+
+const (
+	// ExportPending means the export is queued but not picked up yet.
+	ExportPending ExportStatus = "pending"
+	// ExportProcessing means a worker is assembling the archive.
+	ExportProcessing ExportStatus = "processing"
+	// ExportReady means the archive is in object storage and
+	// downloadable.
+	ExportReady ExportStatus = "ready"
+	// ExportFailed means assembly gave up; the user can request again.
+	ExportFailed ExportStatus = "failed"
+)
+
+// ErrExportNotFound is returned for unknown export IDs; non-owners get
+// it too, so export IDs cannot be probed.
+var ErrExportNotFound = errors.New("user: export not found")
+
+// Export is one requested data archive. The bytes live in object
+// storage under ObjectKey once the export is ready.
+type Export struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	// Mobile is captured at request time so the user can be notified
+	// when the archive is ready; the profile does not store mobiles.
+	Mobile    string
+	Status    ExportStatus
+	ObjectKey string
+	// Error is the failure reason when Status is failed.
+	Error       string
+	RequestedAt time.Time
+	CompletedAt *time.Time
+}
+
+// ExportRepository persists data exports.
+type ExportRepository interface {
+	Create(ctx context.Context, e *Export) error
+	// GetByID returns the export, or ErrExportNotFound.
+	GetByID(ctx context.Context, id uuid.UUID) (*Export, error)
+	// GetActiveByUser returns the user's pending or processing export,
+	// or ErrExportNotFound when there is none.
+	GetActiveByUser(ctx context.Context, userID uuid.UUID) (*Export, error)
+	// ClaimPending moves up to limit pending exports to processing and
+	// returns them; concurrent workers never claim the same export.
+	ClaimPending(ctx context.Context, limit int) ([]*Export, error)
+	// MarkReady records the finished archive's object key.
+	MarkReady(ctx context.Context, id uuid.UUID, objectKey string) error
+	// MarkFailed records the failure reason.
+	MarkFailed(ctx context.Context, id uuid.UUID, reason string) error
+}
+
+// NotificationPreferences is the notification service's view of a
+// recipient, included in data exports.
+type NotificationPreferences struct {
+	Locale  string
+	OptOuts []string
+}
+
+// PreferenceDirectory reads a user's notification preferences from the
+// notification service.
+type PreferenceDirectory interface {
+	PreferencesOf(ctx context.Context, mobile string) (*NotificationPreferences, error)
+}
+
+// ExportNotifier tells the user their archive is ready, typically by
+// SMS through the notification service. Best-effort.
+type ExportNotifier interface {
+	NotifyExportReady(ctx context.Context, mobile string) error
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "errors"
+
+// KYCLevel is the user's identity-verification tier. Levels only go up;
+// a verified fact does not become unverified.
+type KYCLevel string
+
+const (
+	// KYCNone means nothing beyond the login mobile is known.
+	KYCNone KYCLevel = "none"
+	// KYCMobileVerified means the user holds the mobile (OTP login).
+	KYCMobileVerified KYCLevel = "mobile_verified"
+	// KYCShahkarVerified means Shahkar confirmed the mobile belongs to
+	// the user's national ID.
+	KYCShahkarVerified KYCLevel = "shahkar_verified"
+	// KYCFull means identity documents were reviewed and approved.
+	KYCFull KYCLevel = "full"
+)
+
+// kycRank orders levels for comparisons; unknown levels rank lowest.
+var kycRank = map[KYCLevel]int{
+	KYCNone:            0,
+	KYCMobileVerified:  1,
+	KYCShahkarVerified: 2,
+	KYCFull:            3,
+}
+
+// AtLeast reports whether l meets the required level.
+func (l KYCLevel) AtLeast(required KYCLevel) bool {
+	return kycRank[l] >= kycRank[required]
+}
+
+// Next returns the level one step above l, or l at the top.
+func (l KYCLevel) Next() KYCLevel {
+	switch l {
+	case KYCNone:
+		return KYCMobileVerified
+	case KYCMobileVerified:
+		return KYCShahkarVerified
+	case KYCShahkarVerified:
+		return KYCFull
+	default:
+		return l
+	}
+}
+
+var (
+	// ErrKYCProfileIncomplete is returned when the next level needs
+	// profile fields the user has not filled in yet.
+	ErrKYCProfileIncomplete = errors.New("user: profile incomplete for the requested kyc level")
+
+	// ErrKYCAtTop is returned when there is no level left to reach.
+	ErrKYCAtTop = errors.New("user: already at the highest kyc level")
+
+	// ErrKYCManualReview is returned when the next level (full) cannot be
+	// reached self-service; backoffice grants it after document review.
+	ErrKYCManualReview = errors.New("user: full kyc requires manual review")
+
+	// ErrKYCNotReady is returned when a full-KYC approval targets a user
+	// who has not passed the Shahkar step.
+	ErrKYCNotReady = errors.New("user: shahkar verification required before full kyc")
+)
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Duplicate match kinds. national_id matches predate the unique index
+// on the column; name_birthdate matches are heuristic and need a human
+// look before merging.
+const (
+	MatchNationalID    = "national_id"
+	MatchNameBirthDate = "name_birthdate"
+)
+
+var (
+	// ErrMergeSameUser is returned when survivor and duplicate are the
+	// same account.
+	ErrMergeSameUser = errors.New("user: cannot merge an account into itself")
+)
+
+// DuplicateGroup is a set of accounts that look like the same person.
+type DuplicateGroup struct {
+	// MatchKind is one of the Match* constants.
+	MatchKind string
+	// MatchKey is the shared value the accounts matched on.
+	MatchKey string
+	UserIDs  []uuid.UUID
+}
+
+// MergeRecord is one audit record of an account merge.
+type MergeRecord struct {
+	ID          uuid.UUID
+	SurvivorID  uuid.UUID
+	DuplicateID uuid.UUID
+	// ActorID is the operator who merged.
+	ActorID uuid.UUID
+	Note    string
+	// BusinessesMoved counts the businesses reassigned to the survivor.
+	BusinessesMoved int64
+	CreatedAt       time.Time
+}
+
+// MergeAuditRepository persists the merge audit trail.
+type MergeAuditRepository interface {
+	Record(ctx context.Context, m *MergeRecord) error
+	// ListByUser returns merges the user took part in, either side,
+	// newest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*MergeRecord, error)
+}
+
+// BusinessReassigner moves every business of one owner to another in
+// the customer service, returning how many moved.
+type BusinessReassigner interface {
+	ReassignBusinesses(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrProfileNotFound is returned when no profile exists for the user.
+	ErrProfileNotFound = errors.New("user: profile not found")
+
+	// ErrInvalidNationalID is returned when a code melli fails the
+	// checksum.
+	ErrInvalidNationalID = errors.New("user: invalid national id")
+
+	// ErrInvalidEmail is returned for malformed email addresses.
+	ErrInvalidEmail = errors.New("user: invalid email")
+
+	// ErrInvalidBirthDate is returned for birthdates outside the
+	// plausible range.
+	ErrInvalidBirthDate = errors.New("user: invalid birthdate")
+
+	// ErrDuplicateNationalID is returned when another user already
+	// registered the national ID.
+	ErrDuplicateNationalID = errors.New("user: national id already registered")
+)
+
+// Profile is a user's personal data, keyed by the auth user ID.
+type Profile struct {
+	UserID    uuid.UUID
+	FirstName string
+	LastName  string
+	// NationalID is the personal code melli; empty until the user
+	// provides it.
+	NationalID string
+	BirthDate  *time.Time
+	Email      string
+	// KYCLevel is the verification tier; see the KYCLevel constants.
+	KYCLevel KYCLevel
+	// BlockedAt is set while the user is blocked; BlockReason holds the
+	// reason code of the active block.
+	BlockedAt   *time.Time
+	BlockReason string
+	// IdentityVerifiedAt records a successful civil-registry match of
+	// name and birthdate against the national ID.
+	IdentityVerifiedAt *time.Time
+	// AvatarURL is the public URL of the avatar thumbnail; empty until
+	// the user uploads one.
+	AvatarURL string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Blocked reports whether the user is currently blocked.
+func (p *Profile) Blocked() bool { return p.BlockedAt != nil }
+
+// IdentityVerified reports whether the civil registry confirmed the
+// profile's identity.
+func (p *Profile) IdentityVerified() bool { return p.IdentityVerifiedAt != nil }
+
+// ProfileRepository persists user profiles.
+type ProfileRepository interface {
+	// GetByUserID returns the profile, or ErrProfileNotFound.
+	GetByUserID(ctx context.Context, userID uuid.UUID) (*Profile, error)
+	// Upsert creates the profile on first write and updates it after.
+	// The KYC level is never written by Upsert; use SetKYCLevel.
+	Upsert(ctx context.Context, p *Profile) (*Profile, error)
+	// SetKYCLevel moves the user to a new level, creating a bare
+	// profile row for users who advance before saving one.
+	SetKYCLevel(ctx context.Context, userID uuid.UUID, level KYCLevel) (*Profile, error)
+	// Search pages profiles matching the filter, newest first.
+	Search(ctx context.Context, filter ProfileSearch, limit, offset int) ([]*Profile, error)
+	// SetBlocked blocks (reason required) or unblocks the user, creating
+	// a bare profile row if needed.
+	SetBlocked(ctx context.Context, userID uuid.UUID, blocked bool, reason string) (*Profile, error)
+	// SetIdentityVerified stamps (or clears) the civil-registry match.
+	SetIdentityVerified(ctx context.Context, userID uuid.UUID, verified bool) (*Profile, error)
+	// SetAvatar stores the avatar's public URL; empty clears it.
+	SetAvatar(ctx context.Context, userID uuid.UUID, avatarURL string) (*Profile, error)
+	// FindDuplicates returns groups of accounts sharing a national ID or
+	// a name/birthdate combination, for backoffice merge review.
+	FindDuplicates(ctx context.Context, limit int) ([]DuplicateGroup, error)
+}
+
+// ProfileSearch narrows backoffice profile searches; zero values mean
+// "any". NationalID is an exact match, Name fuzzy-matches first and
+// last name.
+type ProfileSearch struct {
+	NationalID string
+	Name       string
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrIdentityMismatch is returned when the civil registry's record
+	// for the national ID does not match the submitted name/birthdate.
+	ErrIdentityMismatch = errors.New("user: identity does not match the civil registry")
+
+	// ErrSabteahvalUnavailable is returned when the registry cannot be
+	// reached or keeps failing.
+	ErrSabteahvalUnavailable = errors.New("user: civil registry unavailable")
+
+	// ErrIdentityNotVerified is returned when a flow requires a
+	// registry-verified identity the user does not have yet.
+	ErrIdentityNotVerified = errors.New("user: identity not verified against the civil registry")
+)
+
+// IdentityVerifier checks name and birthdate against the civil registry
+// (Sabteahval) record for a national ID. A nil error means they match.
+type IdentityVerifier interface {
+	VerifyIdentity(ctx context.Context, nationalID, firstName, lastName string, birthDate time.Time) error
+}
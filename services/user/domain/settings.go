@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrUnknownSetting is returned for setting keys the service does not
+	// know.
+	ErrUnknownSetting = errors.New("user: unknown setting")
+
+	// ErrInvalidSettingValue is returned when a value is outside the
+	// key's allowed set.
+	ErrInvalidSettingValue = errors.New("user: invalid setting value")
+)
+
+// Known setting keys. Settings are free-form strings on the wire but
+// every key has a closed value set and a default.
+const (
+	// SettingLanguage is the user's interface and message language.
+	SettingLanguage = "language"
+	// SettingMarketingSMS opts marketing SMS on or off; transactional
+	// traffic is unaffected.
+	SettingMarketingSMS = "notifications.marketing_sms"
+	// SettingDashboardTheme is the merchant dashboard color theme.
+	SettingDashboardTheme = "dashboard.theme"
+)
+
+// settingValues is the allowed-value set per key; the first entry is the
+// default a user gets before saving anything.
+var settingValues = map[string][]string{
+	SettingLanguage:       {"fa", "en"},
+	SettingMarketingSMS:   {"on", "off"},
+	SettingDashboardTheme: {"system", "light", "dark"},
+}
+
+// ValidateSetting checks a key/value pair against the registry.
+func ValidateSetting(key, value string) error {
+	allowed, ok := settingValues[key]
+	if !ok {
+		return ErrUnknownSetting
+	}
+	for _, v := range allowed {
+		if v == value {
+			return nil
+		}
+	}
+	return ErrInvalidSettingValue
+}
+
+// Settings is a user's saved preferences, keyed by setting name. Reads
+// go through the typed accessors, which fall back to defaults for keys
+// the user never saved.
+type Settings map[string]string
+
+// get returns the stored value or the key's default.
+func (s Settings) get(key string) string {
+	if v, ok := s[key]; ok {
+		return v
+	}
+	return settingValues[key][0]
+}
+
+// Language returns the user's language, "fa" by default.
+func (s Settings) Language() string { return s.get(SettingLanguage) }
+
+// MarketingSMS reports whether marketing SMS is enabled (the default).
+func (s Settings) MarketingSMS() bool { return s.get(SettingMarketingSMS) == "on" }
+
+// DashboardTheme returns the dashboard theme, "system" by default.
+func (s Settings) DashboardTheme() string { return s.get(SettingDashboardTheme) }
+
+// WithDefaults returns a copy with every known key present, so clients
+// see the effective value rather than having to know the defaults.
+func (s Settings) WithDefaults() Settings {
+	out := make(Settings, len(settingValues))
+	for key := range settingValues {
+		out[key] = s.get(key)
+	}
+	return out
+}
+
+// SettingsRepository persists user settings.
+type SettingsRepository interface {
+	// GetByUserID returns the user's saved settings; never-saved keys are
+	// simply absent.
+	GetByUserID(ctx context.Context, userID uuid.UUID) (Settings, error)
+	// Upsert writes the given keys, leaving others untouched.
+	Upsert(ctx context.Context, userID uuid.UUID, values map[string]string) error
+}
+
+// LocaleSyncer pushes a language change to the notification service so
+// message rendering follows the user's choice. Implementations are
+// best-effort; settings writes never fail on a sync error.
+type LocaleSyncer interface {
+	SyncLocale(ctx context.Context, mobile, locale string) error
+}
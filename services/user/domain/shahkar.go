@@ -0,0 +1,28 @@
+// Package domain defines the user service's entities and ports.
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrMobileNotOwned is returned when Shahkar reports the mobile
+	// number is not registered to the national ID.
+	ErrMobileNotOwned = errors.New("user: mobile is not registered to this national id")
+
+	// ErrShahkarRejected is returned when Shahkar refuses the inquiry
+	// itself (malformed number, unsupported operator, ...).
+	ErrShahkarRejected = errors.New("user: shahkar rejected the inquiry")
+
+	// ErrShahkarUnavailable is returned when Shahkar cannot be reached or
+	// keeps failing; the caller decides whether to queue or give up.
+	ErrShahkarUnavailable = errors.New("user: shahkar unavailable")
+)
+
+// MobileOwnershipVerifier answers whether a mobile number is registered
+// to a national ID — the Shahkar inquiry that anchors KYC. A nil error
+// means the pair matches.
+type MobileOwnershipVerifier interface {
+	VerifyMobileOwnership(ctx context.Context, nationalID, mobile string) error
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"net/mail"
+	"time"
+)
+
+// ValidateNationalCode checks a 10-digit code melli against its check
+// digit: sum(d[i] * (10-i)) mod 11 must equal the last digit, or 11
+// minus it when the remainder is 2 or more.
+func ValidateNationalCode(id string) bool {
+	if len(id) != 10 {
+		return false
+	}
+	same := true
+	sum := 0
+	for i := 0; i < 9; i++ {
+		if id[i] < '0' || id[i] > '9' {
+			return false
+		}
+		if id[i] != id[0] {
+			same = false
+		}
+		sum += int(id[i]-'0') * (10 - i)
+	}
+	if id[9] < '0' || id[9] > '9' {
+		return false
+	}
+	// Repdigits satisfy the checksum but were never issued.
+	if same && id[9] == id[0] {
+		return false
+	}
+	remainder := sum % 11
+	check := int(id[9] - '0')
+	if remainder < 2 {
+		return check == remainder
+	}
+	return check == 11-remainder
+}
+
+// ValidateEmail accepts a bare RFC 5322 address (no display name).
+func ValidateEmail(email string) bool {
+	addr, err := mail.ParseAddress(email)
+	return err == nil && addr.Address == email
+}
+
+// ValidateBirthDate bounds birthdates to living adults-to-be: not in
+// the future and not before 1900.
+func ValidateBirthDate(d time.Time) bool {
+	return d.After(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)) && d.Before(time.Now())
+}
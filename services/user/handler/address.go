@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+	"github.com/K-H-Tech/infera/services/user/domain"
+	"github.com/K-H-Tech/infera/services/user/service"
+)
+
+func (h *UserHandler) CreateAddress(ctx context.Context, req *userv1.CreateAddressRequest) (*userv1.CreateAddressResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if req.GetPostalCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "postal_code is required")
+	}
+	address, err := h.users.CreateAddress(ctx, c.UserID, addressInput(req.GetAddress(), req.GetPostalCode()))
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.CreateAddressResponse{Address: addressToProto(address)}, nil
+}
+
+func (h *UserHandler) UpdateAddress(ctx context.Context, req *userv1.UpdateAddressRequest) (*userv1.UpdateAddressResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	addressID, err := uuid.Parse(req.GetAddressId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address_id")
+	}
+	address, err := h.users.UpdateAddress(ctx, c.UserID, addressID, addressInput(req.GetAddress(), req.GetPostalCode()))
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.UpdateAddressResponse{Address: addressToProto(address)}, nil
+}
+
+func (h *UserHandler) ListAddresses(ctx context.Context, req *userv1.ListAddressesRequest) (*userv1.ListAddressesResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	addresses, err := h.users.ListAddresses(ctx, c.UserID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	resp := &userv1.ListAddressesResponse{}
+	for _, a := range addresses {
+		resp.Addresses = append(resp.Addresses, addressToProto(a))
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) DeleteAddress(ctx context.Context, req *userv1.DeleteAddressRequest) (*userv1.DeleteAddressResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	addressID, err := uuid.Parse(req.GetAddressId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address_id")
+	}
+	if err := h.users.DeleteAddress(ctx, c.UserID, addressID); err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.DeleteAddressResponse{}, nil
+}
+
+func (h *UserHandler) SetPrimaryAddress(ctx context.Context, req *userv1.SetPrimaryAddressRequest) (*userv1.SetPrimaryAddressResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	addressID, err := uuid.Parse(req.GetAddressId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address_id")
+	}
+	if err := h.users.SetPrimaryAddress(ctx, c.UserID, addressID); err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.SetPrimaryAddressResponse{}, nil
+}
+
+func addressInput(a *userv1.AddressInput, postalCode string) service.AddressInput {
+	return service.AddressInput{
+		Label:      a.GetLabel(),
+		Province:   a.GetProvince(),
+		City:       a.GetCity(),
+		Street:     a.GetStreet(),
+		Details:    a.GetDetails(),
+		PostalCode: postalCode,
+	}
+}
+
+func addressToProto(a *domain.Address) *userv1.Address {
+	return &userv1.Address{
+		Id:         a.ID.String(),
+		Label:      a.Label,
+		Province:   a.Province,
+		City:       a.City,
+		Street:     a.Street,
+		Details:    a.Details,
+		PostalCode: a.PostalCode,
+		Primary:    a.Primary,
+		Verified:   a.Verified,
+		CreatedAt:  timestamppb.New(a.CreatedAt),
+		UpdatedAt:  timestamppb.New(a.UpdatedAt),
+	}
+}
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+	"github.com/K-H-Tech/infera/services/user/service"
+)
+
+// BackofficeHandler adapts the operator use cases to the
+// user.v1.UserBackofficeService gRPC API.
+type BackofficeHandler struct {
+	userv1.UnimplementedUserBackofficeServiceServer
+
+	users *service.UserService
+	log   *slog.Logger
+}
+
+// NewBackofficeHandler builds the backoffice handler.
+func NewBackofficeHandler(users *service.UserService, log *slog.Logger) *BackofficeHandler {
+	return &BackofficeHandler{users: users, log: log}
+}
+
+func (h *BackofficeHandler) SearchUsers(ctx context.Context, req *userv1.SearchUsersRequest) (*userv1.SearchUsersResponse, error) {
+	if _, err := requireBackoffice(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetMobile() == "" && req.GetNationalId() == "" && req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "mobile, national_id, or name is required")
+	}
+	profiles, err := h.users.SearchUsers(ctx, service.UserSearch{
+		Mobile:     req.GetMobile(),
+		NationalID: req.GetNationalId(),
+		Name:       req.GetName(),
+	}, int(req.GetPageSize()), int(req.GetPage()))
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	resp := &userv1.SearchUsersResponse{}
+	for _, p := range profiles {
+		resp.Profiles = append(resp.Profiles, profileToProto(p))
+	}
+	return resp, nil
+}
+
+func (h *BackofficeHandler) BlockUser(ctx context.Context, req *userv1.BlockUserRequest) (*userv1.BlockUserResponse, error) {
+	c, err := requireBackoffice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	profile, err := h.users.BlockUser(ctx, c.UserID, userID, req.GetReason(), req.GetNote())
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.BlockUserResponse{Profile: profileToProto(profile)}, nil
+}
+
+func (h *BackofficeHandler) UnblockUser(ctx context.Context, req *userv1.UnblockUserRequest) (*userv1.UnblockUserResponse, error) {
+	c, err := requireBackoffice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	profile, err := h.users.UnblockUser(ctx, c.UserID, userID, req.GetNote())
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.UnblockUserResponse{Profile: profileToProto(profile)}, nil
+}
+
+func (h *BackofficeHandler) ListDuplicateUsers(ctx context.Context, req *userv1.ListDuplicateUsersRequest) (*userv1.ListDuplicateUsersResponse, error) {
+	if _, err := requireBackoffice(ctx); err != nil {
+		return nil, err
+	}
+	groups, err := h.users.FindDuplicateUsers(ctx, int(req.GetLimit()))
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	resp := &userv1.ListDuplicateUsersResponse{}
+	for _, g := range groups {
+		group := &userv1.DuplicateGroup{
+			MatchKind: g.MatchKind,
+			MatchKey:  g.MatchKey,
+		}
+		for _, id := range g.UserIDs {
+			group.UserIds = append(group.UserIds, id.String())
+		}
+		resp.Groups = append(resp.Groups, group)
+	}
+	return resp, nil
+}
+
+func (h *BackofficeHandler) MergeUsers(ctx context.Context, req *userv1.MergeUsersRequest) (*userv1.MergeUsersResponse, error) {
+	c, err := requireBackoffice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	survivorID, err := uuid.Parse(req.GetSurvivorUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid survivor_user_id")
+	}
+	duplicateID, err := uuid.Parse(req.GetDuplicateUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid duplicate_user_id")
+	}
+	record, err := h.users.MergeUsers(ctx, c.UserID, survivorID, duplicateID, req.GetNote())
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.MergeUsersResponse{BusinessesMoved: record.BusinessesMoved}, nil
+}
+
+func (h *BackofficeHandler) GetUserDetail(ctx context.Context, req *userv1.GetUserDetailRequest) (*userv1.GetUserDetailResponse, error) {
+	if _, err := requireBackoffice(ctx); err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	detail, err := h.users.GetUserDetail(ctx, userID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+
+	resp := &userv1.GetUserDetailResponse{
+		Profile:  profileToProto(detail.Profile),
+		KycLevel: string(detail.Profile.KYCLevel),
+	}
+	if detail.Logins != nil {
+		resp.Logins = &userv1.LoginSummary{
+			Total:       detail.Logins.Total,
+			LastLoginIp: detail.Logins.LastIP,
+		}
+		if detail.Logins.LastAt != nil {
+			resp.Logins.LastLoginAt = timestamppb.New(*detail.Logins.LastAt)
+		}
+	}
+	for _, b := range detail.Businesses {
+		resp.Businesses = append(resp.Businesses, &userv1.BusinessSummary{
+			Id:          b.ID,
+			Url:         b.URL,
+			WebsiteName: b.WebsiteName,
+			Status:      b.Status,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+	"github.com/K-H-Tech/infera/services/user/service"
+)
+
+// DashboardHandler adapts the dashboard aggregate to the
+// user.v1.UserDashboardService gRPC API.
+type DashboardHandler struct {
+	userv1.UnimplementedUserDashboardServiceServer
+
+	users *service.UserService
+	log   *slog.Logger
+}
+
+// NewDashboardHandler builds the dashboard handler.
+func NewDashboardHandler(users *service.UserService, log *slog.Logger) *DashboardHandler {
+	return &DashboardHandler{users: users, log: log}
+}
+
+func (h *DashboardHandler) GetDashboard(ctx context.Context, req *userv1.GetDashboardRequest) (*userv1.GetDashboardResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	dashboard, err := h.users.GetDashboard(ctx, c.UserID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+
+	resp := &userv1.GetDashboardResponse{
+		Profile:      profileToProto(dashboard.Profile),
+		KycLevel:     string(dashboard.Profile.KYCLevel),
+		NextKycLevel: string(dashboard.NextKYC),
+	}
+	if dashboard.Logins != nil {
+		resp.Logins = &userv1.LoginSummary{
+			Total:       dashboard.Logins.Total,
+			LastLoginIp: dashboard.Logins.LastIP,
+		}
+		if dashboard.Logins.LastAt != nil {
+			resp.Logins.LastLoginAt = timestamppb.New(*dashboard.Logins.LastAt)
+		}
+	}
+	for _, b := range dashboard.Businesses {
+		resp.Businesses = append(resp.Businesses, &userv1.BusinessSummary{
+			Id:          b.ID,
+			Url:         b.URL,
+			WebsiteName: b.WebsiteName,
+			Status:      b.Status,
+		})
+	}
+	return resp, nil
+}
+
+func (h *DashboardHandler) RequestAvatarUpload(ctx context.Context, req *userv1.RequestAvatarUploadRequest) (*userv1.RequestAvatarUploadResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	uploadID, uploadURL, err := h.users.RequestAvatarUpload(ctx, c.UserID, req.GetContentType(), req.GetSizeBytes())
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.RequestAvatarUploadResponse{
+		UploadId:  uploadID,
+		UploadUrl: uploadURL,
+	}, nil
+}
+
+func (h *DashboardHandler) ConfirmAvatarUpload(ctx context.Context, req *userv1.ConfirmAvatarUploadRequest) (*userv1.ConfirmAvatarUploadResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	profile, err := h.users.ConfirmAvatarUpload(ctx, c.UserID, req.GetUploadId())
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.ConfirmAvatarUploadResponse{AvatarUrl: profile.AvatarURL}, nil
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+func (h *UserHandler) ExportMyData(ctx context.Context, req *userv1.ExportMyDataRequest) (*userv1.ExportMyDataResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	export, err := h.users.ExportMyData(ctx, c.UserID, c.Mobile)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.ExportMyDataResponse{Export: exportToProto(export)}, nil
+}
+
+func (h *UserHandler) GetDataExport(ctx context.Context, req *userv1.GetDataExportRequest) (*userv1.GetDataExportResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	exportID, err := uuid.Parse(req.GetExportId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid export_id")
+	}
+	export, downloadURL, err := h.users.DataExport(ctx, c.UserID, exportID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.GetDataExportResponse{
+		Export:      exportToProto(export),
+		DownloadUrl: downloadURL,
+	}, nil
+}
+
+func exportToProto(e *domain.Export) *userv1.DataExport {
+	proto := &userv1.DataExport{
+		Id:          e.ID.String(),
+		Status:      string(e.Status),
+		RequestedAt: timestamppb.New(e.RequestedAt),
+	}
+	if e.CompletedAt != nil {
+		proto.CompletedAt = timestamppb.New(*e.CompletedAt)
+	}
+	return proto
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+)
+
+// RoleBackoffice marks operators allowed to review KYC and act on other
+// users' records.
+const RoleBackoffice = "backoffice"
+
+// requireBackoffice rejects callers without the backoffice role.
+func requireBackoffice(ctx context.Context) (*claims.Claims, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	if !c.HasRole(RoleBackoffice) {
+		return nil, status.Error(codes.PermissionDenied, "requires the backoffice role")
+	}
+	return c, nil
+}
+
+func (h *UserHandler) GetKycStatus(ctx context.Context, req *userv1.GetKycStatusRequest) (*userv1.GetKycStatusResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	level, next, err := h.users.KYCStatus(ctx, c.UserID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.GetKycStatusResponse{
+		KycLevel:  string(level),
+		NextLevel: string(next),
+	}, nil
+}
+
+func (h *UserHandler) AdvanceKyc(ctx context.Context, req *userv1.AdvanceKycRequest) (*userv1.AdvanceKycResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	level, err := h.users.AdvanceKYC(ctx, c.UserID, c.Mobile)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.AdvanceKycResponse{KycLevel: string(level)}, nil
+}
+
+func (h *UserHandler) VerifyIdentity(ctx context.Context, req *userv1.VerifyIdentityRequest) (*userv1.VerifyIdentityResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	profile, err := h.users.VerifyIdentity(ctx, c.UserID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.VerifyIdentityResponse{IdentityVerified: profile.IdentityVerified()}, nil
+}
+
+func (h *UserHandler) ApproveFullKyc(ctx context.Context, req *userv1.ApproveFullKycRequest) (*userv1.ApproveFullKycResponse, error) {
+	c, err := requireBackoffice(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	level, err := h.users.ApproveFullKYC(ctx, c.UserID, userID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.ApproveFullKycResponse{KycLevel: string(level)}, nil
+}
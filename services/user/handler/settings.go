@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+)
+
+func (h *UserHandler) GetSettings(ctx context.Context, req *userv1.GetSettingsRequest) (*userv1.GetSettingsResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	settings, err := h.users.GetSettings(ctx, c.UserID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.GetSettingsResponse{Settings: map[string]string(settings)}, nil
+}
+
+func (h *UserHandler) UpdateSettings(ctx context.Context, req *userv1.UpdateSettingsRequest) (*userv1.UpdateSettingsResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	settings, err := h.users.UpdateSettings(ctx, c.UserID, c.Mobile, req.GetSettings())
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.UpdateSettingsResponse{Settings: map[string]string(settings)}, nil
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// GetUserStatus is the service-to-service standing check; the customer
+// service gates business creation on it. Unknown users are NotFound.
+func (h *UserHandler) GetUserStatus(ctx context.Context, req *userv1.GetUserStatusRequest) (*userv1.GetUserStatusResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	profile, err := h.users.UserStatus(ctx, userID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.GetUserStatusResponse{
+		UserId:    profile.UserID.String(),
+		KycStatus: kycStatusOf(profile.KYCLevel),
+		Blocked:   profile.Blocked(),
+	}, nil
+}
+
+// kycStatusOf flattens the level ladder onto the coarse contract
+// sibling services consume ("none", "pending", "verified").
+func kycStatusOf(level domain.KYCLevel) string {
+	switch {
+	case level.AtLeast(domain.KYCShahkarVerified):
+		return "verified"
+	case level == domain.KYCMobileVerified:
+		return "pending"
+	default:
+		return "none"
+	}
+}
@@ -0,0 +1,168 @@
+// Package handler adapts the user service to its gRPC API.
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/K-H-Tech/infera/core/claims"
+	userv1 "github.com/K-H-Tech/infera/proto/gen/go/user/v1"
+	"github.com/K-H-Tech/infera/services/user/domain"
+	"github.com/K-H-Tech/infera/services/user/service"
+)
+
+// birthDateLayout is the wire format of birthdates; they are calendar
+// dates, not instants.
+const birthDateLayout = "2006-01-02"
+
+// UserHandler adapts the user use cases to the user.v1 gRPC API.
+type UserHandler struct {
+	userv1.UnimplementedUserServiceServer
+
+	users *service.UserService
+	log   *slog.Logger
+}
+
+// NewUserHandler builds the user handler.
+func NewUserHandler(users *service.UserService, log *slog.Logger) *UserHandler {
+	return &UserHandler{users: users, log: log}
+}
+
+func (h *UserHandler) GetProfile(ctx context.Context, req *userv1.GetProfileRequest) (*userv1.GetProfileResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+	profile, err := h.users.GetProfile(ctx, c.UserID)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.GetProfileResponse{Profile: profileToProto(profile)}, nil
+}
+
+func (h *UserHandler) UpdateProfile(ctx context.Context, req *userv1.UpdateProfileRequest) (*userv1.UpdateProfileResponse, error) {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing identity")
+	}
+
+	// Without a mask every field is updated; with a mask only the named
+	// paths change.
+	update := service.ProfileUpdate{}
+	for _, path := range maskPaths(req.GetUpdateMask().GetPaths(), "first_name", "last_name", "national_id", "birth_date", "email") {
+		switch path {
+		case "first_name":
+			v := req.GetFirstName()
+			update.FirstName = &v
+		case "last_name":
+			v := req.GetLastName()
+			update.LastName = &v
+		case "national_id":
+			v := req.GetNationalId()
+			update.NationalID = &v
+		case "birth_date":
+			if req.GetBirthDate() == "" {
+				break
+			}
+			d, err := time.Parse(birthDateLayout, req.GetBirthDate())
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, "birth_date must be YYYY-MM-DD")
+			}
+			update.BirthDate = &d
+		case "email":
+			v := req.GetEmail()
+			update.Email = &v
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown update_mask path %q", path)
+		}
+	}
+
+	profile, err := h.users.UpdateProfile(ctx, c.UserID, update)
+	if err != nil {
+		return nil, mapUserError(err)
+	}
+	return &userv1.UpdateProfileResponse{Profile: profileToProto(profile)}, nil
+}
+
+// maskPaths returns the mask's paths, or every known path when the mask
+// is absent.
+func maskPaths(paths []string, all ...string) []string {
+	if len(paths) == 0 {
+		return all
+	}
+	return paths
+}
+
+func profileToProto(p *domain.Profile) *userv1.Profile {
+	proto := &userv1.Profile{
+		UserId:           p.UserID.String(),
+		FirstName:        p.FirstName,
+		LastName:         p.LastName,
+		NationalId:       p.NationalID,
+		Email:            p.Email,
+		KycLevel:         string(p.KYCLevel),
+		Blocked:          p.Blocked(),
+		BlockReason:      p.BlockReason,
+		IdentityVerified: p.IdentityVerified(),
+		AvatarUrl:        p.AvatarURL,
+	}
+	if p.BirthDate != nil {
+		proto.BirthDate = p.BirthDate.Format(birthDateLayout)
+	}
+	if !p.CreatedAt.IsZero() {
+		proto.CreatedAt = timestamppb.New(p.CreatedAt)
+		proto.UpdatedAt = timestamppb.New(p.UpdatedAt)
+	}
+	return proto
+}
+
+func mapUserError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrProfileNotFound):
+		return status.Error(codes.NotFound, "profile not found")
+	case errors.Is(err, domain.ErrInvalidNationalID), errors.Is(err, domain.ErrInvalidEmail),
+		errors.Is(err, domain.ErrInvalidBirthDate):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDuplicateNationalID):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrKYCProfileIncomplete), errors.Is(err, domain.ErrKYCAtTop),
+		errors.Is(err, domain.ErrKYCManualReview), errors.Is(err, domain.ErrKYCNotReady),
+		errors.Is(err, domain.ErrMobileNotOwned):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrShahkarRejected):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrShahkarUnavailable), errors.Is(err, domain.ErrSabteahvalUnavailable):
+		return status.Error(codes.Unavailable, "identity verification is temporarily unavailable")
+	case errors.Is(err, domain.ErrIdentityMismatch), errors.Is(err, domain.ErrIdentityNotVerified):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrAddressNotFound):
+		return status.Error(codes.NotFound, "address not found")
+	case errors.Is(err, domain.ErrInvalidPostalCode), errors.Is(err, domain.ErrPostalCodeUnknown):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyAddresses):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, domain.ErrUnknownSetting), errors.Is(err, domain.ErrInvalidSettingValue):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrUnsupportedImageType), errors.Is(err, domain.ErrImageTooLarge),
+		errors.Is(err, domain.ErrInvalidUpload):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrMergeSameUser):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrExportNotFound):
+		return status.Error(codes.NotFound, "export not found")
+	case errors.Is(err, service.ErrStorageUnavailable):
+		return status.Error(codes.Unavailable, "object storage is temporarily unavailable")
+	case errors.Is(err, domain.ErrInvalidBlockReason):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrAlreadyBlocked), errors.Is(err, domain.ErrNotBlocked):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
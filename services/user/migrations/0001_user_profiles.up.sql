@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS user_profiles (
+    user_id      UUID PRIMARY KEY,
+    first_name   TEXT NOT NULL DEFAULT '',
+    last_name    TEXT NOT NULL DEFAULT '',
+    national_id  TEXT NOT NULL DEFAULT '',
+    birth_date   DATE,
+    email        TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL
+);
+
+-- One user per national ID; profiles without one don't collide.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_profiles_national_id
+    ON user_profiles (national_id)
+    WHERE national_id <> '';
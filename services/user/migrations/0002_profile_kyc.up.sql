@@ -0,0 +1,6 @@
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS kyc_level TEXT NOT NULL DEFAULT 'none';
+
+-- Backoffice reviews the shahkar_verified queue for full-KYC approval.
+CREATE INDEX IF NOT EXISTS idx_user_profiles_kyc_level
+    ON user_profiles (kyc_level);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS user_addresses (
+    id           UUID PRIMARY KEY,
+    user_id      UUID NOT NULL,
+    label        TEXT NOT NULL DEFAULT '',
+    province     TEXT NOT NULL DEFAULT '',
+    city         TEXT NOT NULL DEFAULT '',
+    street       TEXT NOT NULL DEFAULT '',
+    details      TEXT NOT NULL DEFAULT '',
+    postal_code  TEXT NOT NULL,
+    is_primary   BOOLEAN NOT NULL DEFAULT FALSE,
+    verified     BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at   TIMESTAMPTZ NOT NULL,
+    updated_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_addresses_user ON user_addresses (user_id);
+
+-- Exactly one primary address per user.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_addresses_primary
+    ON user_addresses (user_id)
+    WHERE is_primary;
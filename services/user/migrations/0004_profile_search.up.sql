@@ -0,0 +1,5 @@
+-- Trigram index for fuzzy name search in backoffice.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_user_profiles_name_trgm
+    ON user_profiles USING GIN ((first_name || ' ' || last_name) gin_trgm_ops);
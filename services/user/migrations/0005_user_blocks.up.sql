@@ -0,0 +1,16 @@
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS blocked_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS block_reason TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS user_block_events (
+    id          UUID PRIMARY KEY,
+    user_id     UUID NOT NULL,
+    action      TEXT NOT NULL,
+    reason      TEXT NOT NULL DEFAULT '',
+    note        TEXT NOT NULL DEFAULT '',
+    actor_id    UUID NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_block_events_user
+    ON user_block_events (user_id, created_at DESC);
@@ -0,0 +1,15 @@
+-- Transactional outbox for user domain events; the broker relay drains
+-- unpublished rows.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id            UUID PRIMARY KEY,
+    topic         TEXT NOT NULL,
+    key           TEXT NOT NULL DEFAULT '',
+    payload       JSONB NOT NULL,
+    headers       JSONB,
+    occurred_at   TIMESTAMPTZ NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    published_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished
+    ON outbox_events (created_at) WHERE published_at IS NULL;
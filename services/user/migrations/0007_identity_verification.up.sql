@@ -0,0 +1,4 @@
+-- identity_verified_at records a successful match against the civil
+-- registry (Sabteahval); NULL means never verified.
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS identity_verified_at TIMESTAMPTZ;
@@ -0,0 +1,9 @@
+-- Per-user key/value settings (language, notification defaults,
+-- dashboard preferences). Unsaved keys fall back to in-code defaults.
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id    UUID        NOT NULL,
+    key        TEXT        NOT NULL,
+    value      TEXT        NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, key)
+);
@@ -0,0 +1,4 @@
+-- avatar_url is the public (CDN-able) URL of the user's avatar
+-- thumbnail; empty until the user uploads one.
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS avatar_url TEXT NOT NULL DEFAULT '';
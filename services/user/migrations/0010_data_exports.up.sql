@@ -0,0 +1,20 @@
+-- GDPR-style data exports, assembled asynchronously by a worker. The
+-- archive bytes live in object storage under object_key.
+CREATE TABLE IF NOT EXISTS user_data_exports (
+    id           UUID PRIMARY KEY,
+    user_id      UUID        NOT NULL,
+    mobile       TEXT        NOT NULL DEFAULT '',
+    status       TEXT        NOT NULL DEFAULT 'pending',
+    object_key   TEXT        NOT NULL DEFAULT '',
+    error        TEXT        NOT NULL DEFAULT '',
+    requested_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_data_exports_user
+    ON user_data_exports (user_id, requested_at DESC);
+
+-- The worker polls this; only unfinished exports matter.
+CREATE INDEX IF NOT EXISTS idx_user_data_exports_pending
+    ON user_data_exports (requested_at)
+    WHERE status = 'pending';
@@ -0,0 +1,17 @@
+-- Audit trail of backoffice account merges. The losing account is
+-- blocked with reason 'merged'; its businesses move to the survivor.
+CREATE TABLE IF NOT EXISTS user_merge_events (
+    id               UUID PRIMARY KEY,
+    survivor_id      UUID        NOT NULL,
+    duplicate_id     UUID        NOT NULL,
+    actor_id         UUID        NOT NULL,
+    note             TEXT        NOT NULL DEFAULT '',
+    businesses_moved BIGINT      NOT NULL DEFAULT 0,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_merge_events_survivor
+    ON user_merge_events (survivor_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_user_merge_events_duplicate
+    ON user_merge_events (duplicate_id, created_at DESC);
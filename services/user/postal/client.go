@@ -0,0 +1,70 @@
+// Package postal resolves Iranian postal codes against a registry
+// provider.
+package postal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Config configures the postal registry client.
+type Config struct {
+	BaseURL string
+	APIKey  string
+	Timeout time.Duration
+}
+
+// Client talks to the postal registry. It implements
+// domain.PostalLookup.
+type Client struct {
+	http *common.HTTPClient
+}
+
+// New builds a postal registry client.
+func New(cfg Config) *Client {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 5 * time.Second
+	}
+	return &Client{
+		http: common.NewHTTPClient(
+			common.WithBaseURL(cfg.BaseURL),
+			common.WithClientTimeout(cfg.Timeout),
+			common.WithDefaultHeader("Authorization", "Bearer "+cfg.APIKey),
+		),
+	}
+}
+
+// record is the registry's response schema.
+type record struct {
+	Province string `json:"province"`
+	City     string `json:"city"`
+	Street   string `json:"street"`
+}
+
+// Lookup resolves one postal code; unknown codes map to
+// domain.ErrPostalCodeUnknown.
+func (c *Client) Lookup(ctx context.Context, postalCode string) (domain.PostalRecord, error) {
+	resp, err := c.http.Get(ctx, "/api/v1/postal-codes/"+postalCode)
+	if err != nil {
+		return domain.PostalRecord{}, fmt.Errorf("postal: lookup %s: %w", postalCode, err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		_, _ = common.ReadResponseBody(resp)
+		return domain.PostalRecord{}, domain.ErrPostalCodeUnknown
+	}
+	body, err := common.ReadResponseBody(resp)
+	if err != nil {
+		return domain.PostalRecord{}, fmt.Errorf("postal: lookup %s: %w", postalCode, err)
+	}
+	var rec record
+	if err := json.Unmarshal(body, &rec); err != nil {
+		return domain.PostalRecord{}, fmt.Errorf("postal: decode response: %w", err)
+	}
+	return domain.PostalRecord{Province: rec.Province, City: rec.City, Street: rec.Street}, nil
+}
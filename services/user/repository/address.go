@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// AddressRepository is the Postgres implementation of
+// domain.AddressRepository.
+type AddressRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAddressRepository builds the repository over the given pool.
+func NewAddressRepository(pool *pgxpool.Pool) *AddressRepository {
+	return &AddressRepository{pool: pool}
+}
+
+const addressColumns = "id, user_id, label, province, city, street, details, postal_code, is_primary, verified, created_at, updated_at"
+
+func (r *AddressRepository) Create(ctx context.Context, a *domain.Address) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO user_addresses
+			(id, user_id, label, province, city, street, details, postal_code, is_primary, verified, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now(), now())`,
+		a.ID, a.UserID, a.Label, a.Province, a.City, a.Street, a.Details,
+		a.PostalCode, a.Primary, a.Verified)
+	if err != nil {
+		return fmt.Errorf("insert user_addresses: %w", err)
+	}
+	return nil
+}
+
+func (r *AddressRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Address, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+addressColumns+" FROM user_addresses WHERE id = $1", id)
+	return scanAddress(row)
+}
+
+func (r *AddressRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.Address, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+addressColumns+" FROM user_addresses WHERE user_id = $1 ORDER BY is_primary DESC, created_at", userID)
+	if err != nil {
+		return nil, fmt.Errorf("select user_addresses: %w", err)
+	}
+	defer rows.Close()
+
+	var addresses []*domain.Address
+	for rows.Next() {
+		a, err := scanAddress(rows)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, a)
+	}
+	return addresses, rows.Err()
+}
+
+func (r *AddressRepository) CountByUser(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	err := r.pool.QueryRow(ctx,
+		"SELECT count(*) FROM user_addresses WHERE user_id = $1", userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count user_addresses: %w", err)
+	}
+	return count, nil
+}
+
+func (r *AddressRepository) Update(ctx context.Context, a *domain.Address) (*domain.Address, error) {
+	row := r.pool.QueryRow(ctx, `
+		UPDATE user_addresses
+		SET label = $2, province = $3, city = $4, street = $5, details = $6,
+			postal_code = $7, verified = $8, updated_at = now()
+		WHERE id = $1
+		RETURNING `+addressColumns,
+		a.ID, a.Label, a.Province, a.City, a.Street, a.Details, a.PostalCode, a.Verified)
+	return scanAddress(row)
+}
+
+func (r *AddressRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM user_addresses WHERE id = $1", id)
+	if err != nil {
+		return fmt.Errorf("delete user_addresses: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrAddressNotFound
+	}
+	return nil
+}
+
+// SetPrimary demotes the current primary and promotes the new one in a
+// transaction, so the partial unique index never sees two primaries.
+func (r *AddressRepository) SetPrimary(ctx context.Context, userID, id uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin set primary address: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE user_addresses SET is_primary = FALSE, updated_at = now()
+		WHERE user_id = $1 AND is_primary`, userID); err != nil {
+		return fmt.Errorf("demote primary address: %w", err)
+	}
+	tag, err := tx.Exec(ctx, `
+		UPDATE user_addresses SET is_primary = TRUE, updated_at = now()
+		WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("promote primary address: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrAddressNotFound
+	}
+	return tx.Commit(ctx)
+}
+
+func scanAddress(row pgx.Row) (*domain.Address, error) {
+	var a domain.Address
+	err := row.Scan(&a.ID, &a.UserID, &a.Label, &a.Province, &a.City, &a.Street,
+		&a.Details, &a.PostalCode, &a.Primary, &a.Verified, &a.CreatedAt, &a.UpdatedAt)
+	if db.IsNotFound(err) {
+		return nil, domain.ErrAddressNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select user_addresses: %w", err)
+	}
+	return &a, nil
+}
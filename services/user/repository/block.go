@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// BlockAuditRepository is the Postgres implementation of
+// domain.BlockAuditRepository.
+type BlockAuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBlockAuditRepository builds the repository over the given pool.
+func NewBlockAuditRepository(pool *pgxpool.Pool) *BlockAuditRepository {
+	return &BlockAuditRepository{pool: pool}
+}
+
+func (r *BlockAuditRepository) Record(ctx context.Context, e *domain.BlockEvent) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO user_block_events (id, user_id, action, reason, note, actor_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, now())`,
+		e.ID, e.UserID, e.Action, e.Reason, e.Note, e.ActorID)
+	if err != nil {
+		return fmt.Errorf("insert user_block_events: %w", err)
+	}
+	return nil
+}
+
+func (r *BlockAuditRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.BlockEvent, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, user_id, action, reason, note, actor_id, created_at
+		FROM user_block_events
+		WHERE user_id = $1
+		ORDER BY created_at DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("select user_block_events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*domain.BlockEvent
+	for rows.Next() {
+		var e domain.BlockEvent
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Action, &e.Reason, &e.Note, &e.ActorID, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("select user_block_events: %w", err)
+		}
+		events = append(events, &e)
+	}
+	return events, rows.Err()
+}
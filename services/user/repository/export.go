@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// ExportRepository is the Postgres implementation of
+// domain.ExportRepository.
+type ExportRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewExportRepository builds the repository over the given pool.
+func NewExportRepository(pool *pgxpool.Pool) *ExportRepository {
+	return &ExportRepository{pool: pool}
+}
+
+const exportColumns = "id, user_id, mobile, status, object_key, error, requested_at, completed_at"
+
+func (r *ExportRepository) Create(ctx context.Context, e *domain.Export) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO user_data_exports (id, user_id, mobile, status, requested_at)
+		VALUES ($1, $2, $3, $4, now())`,
+		e.ID, e.UserID, e.Mobile, e.Status)
+	if err != nil {
+		return fmt.Errorf("insert user_data_exports: %w", err)
+	}
+	return nil
+}
+
+func (r *ExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Export, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+exportColumns+" FROM user_data_exports WHERE id = $1", id)
+	return scanExport(row)
+}
+
+func (r *ExportRepository) GetActiveByUser(ctx context.Context, userID uuid.UUID) (*domain.Export, error) {
+	row := r.pool.QueryRow(ctx, `
+		SELECT `+exportColumns+` FROM user_data_exports
+		WHERE user_id = $1 AND status IN ('pending', 'processing')
+		ORDER BY requested_at DESC LIMIT 1`, userID)
+	return scanExport(row)
+}
+
+// ClaimPending uses FOR UPDATE SKIP LOCKED so concurrent workers never
+// claim the same export.
+func (r *ExportRepository) ClaimPending(ctx context.Context, limit int) ([]*domain.Export, error) {
+	rows, err := r.pool.Query(ctx, `
+		UPDATE user_data_exports SET status = 'processing'
+		WHERE id IN (
+			SELECT id FROM user_data_exports
+			WHERE status = 'pending'
+			ORDER BY requested_at
+			FOR UPDATE SKIP LOCKED
+			LIMIT $1
+		)
+		RETURNING `+exportColumns, limit)
+	if err != nil {
+		return nil, fmt.Errorf("claim user_data_exports: %w", err)
+	}
+	defer rows.Close()
+
+	var exports []*domain.Export
+	for rows.Next() {
+		e, err := scanExport(rows)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, e)
+	}
+	return exports, rows.Err()
+}
+
+func (r *ExportRepository) MarkReady(ctx context.Context, id uuid.UUID, objectKey string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE user_data_exports
+		SET status = 'ready', object_key = $2, completed_at = now()
+		WHERE id = $1`, id, objectKey)
+	if err != nil {
+		return fmt.Errorf("update user_data_exports: %w", err)
+	}
+	return nil
+}
+
+func (r *ExportRepository) MarkFailed(ctx context.Context, id uuid.UUID, reason string) error {
+	_, err := r.pool.Exec(ctx, `
+		UPDATE user_data_exports
+		SET status = 'failed', error = $2, completed_at = now()
+		WHERE id = $1`, id, reason)
+	if err != nil {
+		return fmt.Errorf("update user_data_exports: %w", err)
+	}
+	return nil
+}
+
+func scanExport(row pgx.Row) (*domain.Export, error) {
+	var e domain.Export
+	err := row.Scan(&e.ID, &e.UserID, &e.Mobile, &e.Status, &e.ObjectKey,
+		&e.Error, &e.RequestedAt, &e.CompletedAt)
+	if db.IsNotFound(err) {
+		return nil, domain.ErrExportNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select user_data_exports: %w", err)
+	}
+	return &e, nil
+}
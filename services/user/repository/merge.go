@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// MergeAuditRepository is the Postgres implementation of
+// domain.MergeAuditRepository.
+type MergeAuditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewMergeAuditRepository builds the repository over the given pool.
+func NewMergeAuditRepository(pool *pgxpool.Pool) *MergeAuditRepository {
+	return &MergeAuditRepository{pool: pool}
+}
+
+func (r *MergeAuditRepository) Record(ctx context.Context, m *domain.MergeRecord) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO user_merge_events
+			(id, survivor_id, duplicate_id, actor_id, note, businesses_moved, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, now())`,
+		m.ID, m.SurvivorID, m.DuplicateID, m.ActorID, m.Note, m.BusinessesMoved)
+	if err != nil {
+		return fmt.Errorf("insert user_merge_events: %w", err)
+	}
+	return nil
+}
+
+func (r *MergeAuditRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*domain.MergeRecord, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id, survivor_id, duplicate_id, actor_id, note, businesses_moved, created_at
+		FROM user_merge_events
+		WHERE survivor_id = $1 OR duplicate_id = $1
+		ORDER BY created_at DESC`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("select user_merge_events: %w", err)
+	}
+	defer rows.Close()
+
+	var records []*domain.MergeRecord
+	for rows.Next() {
+		var m domain.MergeRecord
+		err := rows.Scan(&m.ID, &m.SurvivorID, &m.DuplicateID, &m.ActorID,
+			&m.Note, &m.BusinessesMoved, &m.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("scan user_merge_events: %w", err)
+		}
+		records = append(records, &m)
+	}
+	return records, rows.Err()
+}
@@ -0,0 +1,229 @@
+// Package repository contains the Postgres persistence of the user
+// service.
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/pkg/crypto"
+	"github.com/K-H-Tech/infera/pkg/db"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// ProfileRepository is the Postgres implementation of
+// domain.ProfileRepository.
+type ProfileRepository struct {
+	pool   *pgxpool.Pool
+	cipher *crypto.FieldCipher
+}
+
+// NewProfileRepository builds the repository over the given pool.
+func NewProfileRepository(pool *pgxpool.Pool) *ProfileRepository {
+	return &ProfileRepository{pool: pool}
+}
+
+// WithCipher makes the repository encrypt the national ID at rest.
+// Deterministic mode keeps the unique index, equality search, and
+// duplicate grouping working; rows written before encryption decrypt
+// as plaintext passthrough.
+func (r *ProfileRepository) WithCipher(cipher *crypto.FieldCipher) *ProfileRepository {
+	r.cipher = cipher
+	return r
+}
+
+// encPII encrypts a PII value for storage or lookup; empty strings and
+// cipherless repositories pass through.
+func (r *ProfileRepository) encPII(value string) string {
+	if r.cipher == nil || value == "" {
+		return value
+	}
+	return r.cipher.EncryptDeterministic(value)
+}
+
+const profileColumns = "user_id, first_name, last_name, national_id, birth_date, email, kyc_level, blocked_at, block_reason, identity_verified_at, avatar_url, created_at, updated_at"
+
+func (r *ProfileRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*domain.Profile, error) {
+	row := r.pool.QueryRow(ctx,
+		"SELECT "+profileColumns+" FROM user_profiles WHERE user_id = $1", userID)
+	return r.scanProfile(row)
+}
+
+func (r *ProfileRepository) Upsert(ctx context.Context, p *domain.Profile) (*domain.Profile, error) {
+	// kyc_level is deliberately absent from the update list; profile
+	// edits must not touch verification state.
+	row := r.pool.QueryRow(ctx, `
+		INSERT INTO user_profiles
+			(user_id, first_name, last_name, national_id, birth_date, email, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, now(), now())
+		ON CONFLICT (user_id) DO UPDATE
+		SET first_name = EXCLUDED.first_name,
+			last_name = EXCLUDED.last_name,
+			national_id = EXCLUDED.national_id,
+			birth_date = EXCLUDED.birth_date,
+			email = EXCLUDED.email,
+			updated_at = now()
+		RETURNING `+profileColumns,
+		p.UserID, p.FirstName, p.LastName, r.encPII(p.NationalID), p.BirthDate, p.Email)
+	return r.scanProfile(row)
+}
+
+func (r *ProfileRepository) SetKYCLevel(ctx context.Context, userID uuid.UUID, level domain.KYCLevel) (*domain.Profile, error) {
+	// Users can advance (to mobile_verified) before ever saving a
+	// profile, so the row is created on demand.
+	row := r.pool.QueryRow(ctx, `
+		INSERT INTO user_profiles (user_id, kyc_level, created_at, updated_at)
+		VALUES ($1, $2, now(), now())
+		ON CONFLICT (user_id) DO UPDATE
+		SET kyc_level = EXCLUDED.kyc_level, updated_at = now()
+		RETURNING `+profileColumns,
+		userID, level)
+	return r.scanProfile(row)
+}
+
+func (r *ProfileRepository) SetIdentityVerified(ctx context.Context, userID uuid.UUID, verified bool) (*domain.Profile, error) {
+	row := r.pool.QueryRow(ctx, `
+		UPDATE user_profiles
+		SET identity_verified_at = CASE WHEN $2 THEN now() END, updated_at = now()
+		WHERE user_id = $1
+		RETURNING `+profileColumns,
+		userID, verified)
+	return r.scanProfile(row)
+}
+
+func (r *ProfileRepository) SetAvatar(ctx context.Context, userID uuid.UUID, avatarURL string) (*domain.Profile, error) {
+	// Users can upload an avatar before ever saving a profile, so the
+	// row is created on demand.
+	row := r.pool.QueryRow(ctx, `
+		INSERT INTO user_profiles (user_id, avatar_url, created_at, updated_at)
+		VALUES ($1, $2, now(), now())
+		ON CONFLICT (user_id) DO UPDATE
+		SET avatar_url = EXCLUDED.avatar_url, updated_at = now()
+		RETURNING `+profileColumns,
+		userID, avatarURL)
+	return r.scanProfile(row)
+}
+
+func (r *ProfileRepository) SetBlocked(ctx context.Context, userID uuid.UUID, blocked bool, reason string) (*domain.Profile, error) {
+	if !blocked {
+		reason = ""
+	}
+	row := r.pool.QueryRow(ctx, `
+		INSERT INTO user_profiles (user_id, blocked_at, block_reason, created_at, updated_at)
+		VALUES ($1, CASE WHEN $2 THEN now() END, $3, now(), now())
+		ON CONFLICT (user_id) DO UPDATE
+		SET blocked_at = CASE WHEN $2 THEN now() END,
+			block_reason = $3,
+			updated_at = now()
+		RETURNING `+profileColumns,
+		userID, blocked, reason)
+	return r.scanProfile(row)
+}
+
+// Search pages profiles matching the filter, newest first. The name
+// predicate is served by the idx_user_profiles_name_trgm GIN index.
+func (r *ProfileRepository) Search(ctx context.Context, filter domain.ProfileSearch, limit, offset int) ([]*domain.Profile, error) {
+	where := "WHERE TRUE"
+	args := []any{}
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.NationalID != "" {
+		where += " AND national_id = " + arg(r.encPII(filter.NationalID))
+	}
+	if filter.Name != "" {
+		where += " AND (first_name || ' ' || last_name) ILIKE '%' || " + arg(filter.Name) + " || '%'"
+	}
+	args = append(args, limit, offset)
+	rows, err := r.pool.Query(ctx,
+		"SELECT "+profileColumns+" FROM user_profiles "+where+
+			fmt.Sprintf(" ORDER BY created_at DESC, user_id LIMIT $%d OFFSET $%d", len(args)-1, len(args)),
+		args...)
+	if err != nil {
+		return nil, fmt.Errorf("select user_profiles: %w", err)
+	}
+	defer rows.Close()
+
+	var profiles []*domain.Profile
+	for rows.Next() {
+		p, err := r.scanProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, p)
+	}
+	return profiles, rows.Err()
+}
+
+// FindDuplicates groups accounts sharing a national ID (legacy rows
+// predating the unique index) or a name/birthdate combination.
+func (r *ProfileRepository) FindDuplicates(ctx context.Context, limit int) ([]domain.DuplicateGroup, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT $2::text, national_id, array_agg(user_id::text ORDER BY created_at)
+		FROM user_profiles
+		WHERE national_id <> ''
+		GROUP BY national_id HAVING count(*) > 1
+		UNION ALL
+		SELECT $3::text,
+			lower(first_name) || ' ' || lower(last_name) || ' ' || birth_date::text,
+			array_agg(user_id::text ORDER BY created_at)
+		FROM user_profiles
+		WHERE first_name <> '' AND last_name <> '' AND birth_date IS NOT NULL
+		GROUP BY 2 HAVING count(*) > 1
+		LIMIT $1`,
+		limit, domain.MatchNationalID, domain.MatchNameBirthDate)
+	if err != nil {
+		return nil, fmt.Errorf("select duplicate user_profiles: %w", err)
+	}
+	defer rows.Close()
+
+	var groups []domain.DuplicateGroup
+	for rows.Next() {
+		var group domain.DuplicateGroup
+		var ids []string
+		if err := rows.Scan(&group.MatchKind, &group.MatchKey, &ids); err != nil {
+			return nil, fmt.Errorf("scan duplicate user_profiles: %w", err)
+		}
+		for _, id := range ids {
+			parsed, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("scan duplicate user_profiles: %w", err)
+			}
+			group.UserIDs = append(group.UserIDs, parsed)
+		}
+		if r.cipher != nil && group.MatchKind == domain.MatchNationalID {
+			if group.MatchKey, err = r.cipher.Decrypt(group.MatchKey); err != nil {
+				return nil, fmt.Errorf("decrypt duplicate match key: %w", err)
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+func (r *ProfileRepository) scanProfile(row pgx.Row) (*domain.Profile, error) {
+	var p domain.Profile
+	err := row.Scan(&p.UserID, &p.FirstName, &p.LastName, &p.NationalID,
+		&p.BirthDate, &p.Email, &p.KYCLevel, &p.BlockedAt, &p.BlockReason,
+		&p.IdentityVerifiedAt, &p.AvatarURL, &p.CreatedAt, &p.UpdatedAt)
+	if db.IsNotFound(err) {
+		return nil, domain.ErrProfileNotFound
+	}
+	if db.IsUniqueViolation(err) {
+		return nil, domain.ErrDuplicateNationalID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select user_profiles: %w", err)
+	}
+	if r.cipher != nil {
+		if p.NationalID, err = r.cipher.Decrypt(p.NationalID); err != nil {
+			return nil, fmt.Errorf("decrypt national_id: %w", err)
+		}
+	}
+	return &p, nil
+}
@@ -0,0 +1,121 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/pkg/crypto"
+	"github.com/K-H-Tech/infera/pkg/db/dbtest"
+	"github.com/K-H-Tech/infera/services/user/domain"
+	"github.com/K-H-Tech/infera/services/user/migrations"
+	"github.com/K-H-Tech/infera/services/user/repository"
+)
+
+func TestProfileRepository(t *testing.T) {
+	if testing.Short() {
+		t.Skip("repository tests need Docker")
+	}
+	pool := dbtest.StartPostgres(t, migrations.FS)
+	repo := repository.NewProfileRepository(pool)
+	ctx := context.Background()
+
+	t.Run("upsert round-trips", func(t *testing.T) {
+		userID := uuid.New()
+		saved, err := repo.Upsert(ctx, &domain.Profile{
+			UserID:     userID,
+			FirstName:  "Sara",
+			LastName:   "Ahmadi",
+			NationalID: "0012345678",
+			Email:      "sara@example.com",
+		})
+		if err != nil {
+			t.Fatalf("Upsert: %v", err)
+		}
+		if saved.FirstName != "Sara" || saved.NationalID != "0012345678" {
+			t.Fatalf("Upsert returned %+v", saved)
+		}
+
+		got, err := repo.GetByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("GetByUserID: %v", err)
+		}
+		if got.LastName != "Ahmadi" || got.Email != "sara@example.com" {
+			t.Fatalf("GetByUserID returned %+v", got)
+		}
+		if got.KYCLevel != saved.KYCLevel {
+			t.Fatalf("KYC level changed across round-trip: %q vs %q", got.KYCLevel, saved.KYCLevel)
+		}
+	})
+
+	t.Run("missing profile maps to ErrProfileNotFound", func(t *testing.T) {
+		if _, err := repo.GetByUserID(ctx, uuid.New()); !errors.Is(err, domain.ErrProfileNotFound) {
+			t.Fatalf("GetByUserID: got %v, want ErrProfileNotFound", err)
+		}
+	})
+
+	t.Run("duplicate national ID maps to ErrDuplicateNationalID", func(t *testing.T) {
+		if _, err := repo.Upsert(ctx, &domain.Profile{UserID: uuid.New(), NationalID: "1234567890"}); err != nil {
+			t.Fatalf("Upsert first: %v", err)
+		}
+		_, err := repo.Upsert(ctx, &domain.Profile{UserID: uuid.New(), NationalID: "1234567890"})
+		if !errors.Is(err, domain.ErrDuplicateNationalID) {
+			t.Fatalf("Upsert duplicate: got %v, want ErrDuplicateNationalID", err)
+		}
+	})
+
+	t.Run("kyc row is created on demand", func(t *testing.T) {
+		userID := uuid.New()
+		profile, err := repo.SetKYCLevel(ctx, userID, domain.KYCMobileVerified)
+		if err != nil {
+			t.Fatalf("SetKYCLevel: %v", err)
+		}
+		if profile.KYCLevel != domain.KYCMobileVerified {
+			t.Fatalf("SetKYCLevel returned level %q", profile.KYCLevel)
+		}
+	})
+
+	t.Run("encrypted national ID stays searchable", func(t *testing.T) {
+		cipher, err := crypto.NewFieldCipher("k1", map[string][]byte{
+			"k1": []byte("0123456789abcdef0123456789abcdef"),
+		})
+		if err != nil {
+			t.Fatalf("NewFieldCipher: %v", err)
+		}
+		enc := repository.NewProfileRepository(pool).WithCipher(cipher)
+
+		userID := uuid.New()
+		if _, err := enc.Upsert(ctx, &domain.Profile{UserID: userID, NationalID: "4567891230"}); err != nil {
+			t.Fatalf("Upsert: %v", err)
+		}
+
+		// At rest the column must hold ciphertext, not the melli code.
+		var stored string
+		if err := pool.QueryRow(ctx,
+			"SELECT national_id FROM user_profiles WHERE user_id = $1", userID).Scan(&stored); err != nil {
+			t.Fatalf("select raw national_id: %v", err)
+		}
+		if !strings.HasPrefix(stored, "enc:v1:") {
+			t.Fatalf("national_id stored in the clear: %q", stored)
+		}
+
+		got, err := enc.GetByUserID(ctx, userID)
+		if err != nil {
+			t.Fatalf("GetByUserID: %v", err)
+		}
+		if got.NationalID != "4567891230" {
+			t.Fatalf("decrypt round-trip returned %q", got.NationalID)
+		}
+
+		found, err := enc.Search(ctx, domain.ProfileSearch{NationalID: "4567891230"}, 10, 0)
+		if err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+		if len(found) != 1 || found[0].UserID != userID {
+			t.Fatalf("Search by encrypted national ID returned %d rows", len(found))
+		}
+	})
+}
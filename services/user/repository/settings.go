@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// SettingsRepository is the Postgres implementation of
+// domain.SettingsRepository.
+type SettingsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewSettingsRepository builds the repository over the given pool.
+func NewSettingsRepository(pool *pgxpool.Pool) *SettingsRepository {
+	return &SettingsRepository{pool: pool}
+}
+
+func (r *SettingsRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (domain.Settings, error) {
+	rows, err := r.pool.Query(ctx,
+		"SELECT key, value FROM user_settings WHERE user_id = $1", userID)
+	if err != nil {
+		return nil, fmt.Errorf("select user_settings: %w", err)
+	}
+	defer rows.Close()
+
+	settings := domain.Settings{}
+	for rows.Next() {
+		var key, value string
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, fmt.Errorf("scan user_settings: %w", err)
+		}
+		settings[key] = value
+	}
+	return settings, rows.Err()
+}
+
+func (r *SettingsRepository) Upsert(ctx context.Context, userID uuid.UUID, values map[string]string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for key, value := range values {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO user_settings (user_id, key, value, updated_at)
+			VALUES ($1, $2, $3, now())
+			ON CONFLICT (user_id, key) DO UPDATE
+			SET value = EXCLUDED.value, updated_at = now()`,
+			userID, key, value)
+		if err != nil {
+			return fmt.Errorf("upsert user_settings: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
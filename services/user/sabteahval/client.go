@@ -0,0 +1,116 @@
+// Package sabteahval verifies identities against the national civil
+// registry.
+package sabteahval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Config configures the Sabteahval client.
+type Config struct {
+	BaseURL string
+	APIKey  string
+	Timeout time.Duration
+	// Retries is how many times a failed call is retried. Zero means
+	// DefaultRetries.
+	Retries int
+}
+
+// Default client tuning.
+const (
+	DefaultRetries = 2
+	retryBaseDelay = 500 * time.Millisecond
+)
+
+// birthDateLayout is the registry's date format.
+const birthDateLayout = "2006-01-02"
+
+// Client talks to the civil registry through the provider gateway. It
+// implements domain.IdentityVerifier.
+type Client struct {
+	http    *common.HTTPClient
+	retries int
+}
+
+// New builds a Sabteahval client.
+func New(cfg Config) *Client {
+	if cfg.Retries <= 0 {
+		cfg.Retries = DefaultRetries
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Client{
+		http: common.NewHTTPClient(
+			common.WithBaseURL(cfg.BaseURL),
+			common.WithClientTimeout(cfg.Timeout),
+			common.WithDefaultHeader("Authorization", "Bearer "+cfg.APIKey),
+		),
+		retries: cfg.Retries,
+	}
+}
+
+// verifyRequest is the identity-matching request schema.
+type verifyRequest struct {
+	NationalID string `json:"nationalCode"`
+	FirstName  string `json:"firstName"`
+	LastName   string `json:"lastName"`
+	BirthDate  string `json:"birthDate"`
+}
+
+// verifyResponse is the provider's answer.
+type verifyResponse struct {
+	Matched bool   `json:"matched"`
+	Comment string `json:"comment"`
+}
+
+// VerifyIdentity checks the submitted identity against the registry
+// record for the national ID. Transport failures are retried with
+// backoff; a definitive mismatch is not.
+func (c *Client) VerifyIdentity(ctx context.Context, nationalID, firstName, lastName string, birthDate time.Time) error {
+	payload, err := json.Marshal(verifyRequest{
+		NationalID: nationalID,
+		FirstName:  firstName,
+		LastName:   lastName,
+		BirthDate:  birthDate.Format(birthDateLayout),
+	})
+	if err != nil {
+		return fmt.Errorf("sabteahval: encode request: %w", err)
+	}
+
+	var body []byte
+	for attempt := 0; ; attempt++ {
+		resp, reqErr := c.http.Post(ctx, "/api/v1/identity-matching", "application/json",
+			bytes.NewReader(payload))
+		if reqErr == nil {
+			body, reqErr = common.ReadResponseBody(resp)
+		}
+		if reqErr == nil {
+			break
+		}
+		if attempt >= c.retries || ctx.Err() != nil {
+			return fmt.Errorf("%w: %v", domain.ErrSabteahvalUnavailable, reqErr)
+		}
+		select {
+		case <-time.After(retryBaseDelay << attempt):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	var result verifyResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("sabteahval: decode response: %w", err)
+	}
+	if !result.Matched {
+		return domain.ErrIdentityMismatch
+	}
+	return nil
+}
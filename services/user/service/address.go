@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// maxAddresses bounds one user's address book.
+const maxAddresses = 10
+
+// AddressInput carries the user-supplied fields of an address. Province
+// and city are fallbacks, used only when no postal lookup is configured
+// or the registry record omits them.
+type AddressInput struct {
+	Label      string
+	Province   string
+	City       string
+	Street     string
+	Details    string
+	PostalCode string
+}
+
+// CreateAddress adds an address to the caller's book. The postal code
+// is resolved against the registry when a provider is configured; a
+// successful lookup overrides province and city and marks the address
+// verified. The first address becomes primary.
+func (s *UserService) CreateAddress(ctx context.Context, callerUserID uuid.UUID, input AddressInput) (*domain.Address, error) {
+	if !domain.ValidatePostalCode(input.PostalCode) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidPostalCode, input.PostalCode)
+	}
+	count, err := s.addresses.CountByUser(ctx, callerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxAddresses {
+		return nil, domain.ErrTooManyAddresses
+	}
+
+	address := &domain.Address{
+		ID:         uuid.New(),
+		UserID:     callerUserID,
+		Label:      input.Label,
+		Province:   input.Province,
+		City:       input.City,
+		Street:     input.Street,
+		Details:    input.Details,
+		PostalCode: input.PostalCode,
+		Primary:    count == 0,
+	}
+	if err := s.verifyPostalCode(ctx, address); err != nil {
+		return nil, err
+	}
+	if err := s.addresses.Create(ctx, address); err != nil {
+		return nil, err
+	}
+	s.log.Info("user: address created", "user_id", callerUserID, "address_id", address.ID)
+	return address, nil
+}
+
+// UpdateAddress replaces the user-supplied fields of an address owned
+// by the caller and re-verifies the postal code.
+func (s *UserService) UpdateAddress(ctx context.Context, callerUserID, addressID uuid.UUID, input AddressInput) (*domain.Address, error) {
+	address, err := s.ownedAddress(ctx, callerUserID, addressID)
+	if err != nil {
+		return nil, err
+	}
+	if !domain.ValidatePostalCode(input.PostalCode) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidPostalCode, input.PostalCode)
+	}
+
+	address.Label = input.Label
+	address.Province = input.Province
+	address.City = input.City
+	address.Street = input.Street
+	address.Details = input.Details
+	address.PostalCode = input.PostalCode
+	if err := s.verifyPostalCode(ctx, address); err != nil {
+		return nil, err
+	}
+	updated, err := s.addresses.Update(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("user: address updated", "user_id", callerUserID, "address_id", addressID)
+	return updated, nil
+}
+
+// ListAddresses returns the caller's addresses, primary first.
+func (s *UserService) ListAddresses(ctx context.Context, callerUserID uuid.UUID) ([]*domain.Address, error) {
+	return s.addresses.ListByUser(ctx, callerUserID)
+}
+
+// DeleteAddress removes an address owned by the caller. Deleting the
+// primary promotes the oldest remaining address.
+func (s *UserService) DeleteAddress(ctx context.Context, callerUserID, addressID uuid.UUID) error {
+	address, err := s.ownedAddress(ctx, callerUserID, addressID)
+	if err != nil {
+		return err
+	}
+	if err := s.addresses.Delete(ctx, addressID); err != nil {
+		return err
+	}
+	if address.Primary {
+		remaining, err := s.addresses.ListByUser(ctx, callerUserID)
+		if err != nil {
+			return err
+		}
+		if len(remaining) > 0 {
+			if err := s.addresses.SetPrimary(ctx, callerUserID, remaining[0].ID); err != nil {
+				return err
+			}
+		}
+	}
+	s.log.Info("user: address deleted", "user_id", callerUserID, "address_id", addressID)
+	return nil
+}
+
+// SetPrimaryAddress makes an address owned by the caller the primary
+// one.
+func (s *UserService) SetPrimaryAddress(ctx context.Context, callerUserID, addressID uuid.UUID) error {
+	return s.addresses.SetPrimary(ctx, callerUserID, addressID)
+}
+
+// PrimaryAddress returns the caller's primary address, or
+// ErrAddressNotFound for an empty book.
+func (s *UserService) PrimaryAddress(ctx context.Context, userID uuid.UUID) (*domain.Address, error) {
+	addresses, err := s.addresses.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range addresses {
+		if a.Primary {
+			return a, nil
+		}
+	}
+	return nil, domain.ErrAddressNotFound
+}
+
+// ownedAddress loads an address and checks it belongs to the caller.
+// Non-owners get ErrAddressNotFound, never a permission error, to avoid
+// confirming the ID exists.
+func (s *UserService) ownedAddress(ctx context.Context, callerUserID, addressID uuid.UUID) (*domain.Address, error) {
+	address, err := s.addresses.GetByID(ctx, addressID)
+	if err != nil {
+		return nil, err
+	}
+	if address.UserID != callerUserID {
+		return nil, domain.ErrAddressNotFound
+	}
+	return address, nil
+}
+
+// verifyPostalCode resolves the address's postal code when a registry
+// provider is configured. Registry outages degrade to an unverified
+// address instead of blocking the save; only a definitive "unknown
+// code" rejects it.
+func (s *UserService) verifyPostalCode(ctx context.Context, address *domain.Address) error {
+	address.Verified = false
+	if s.postal == nil {
+		return nil
+	}
+	record, err := s.postal.Lookup(ctx, address.PostalCode)
+	if errors.Is(err, domain.ErrPostalCodeUnknown) {
+		return err
+	}
+	if err != nil {
+		s.log.Warn("user: postal lookup failed", "error", err)
+		return nil
+	}
+	if record.Province != "" {
+		address.Province = record.Province
+	}
+	if record.City != "" {
+		address.City = record.City
+	}
+	address.Verified = true
+	return nil
+}
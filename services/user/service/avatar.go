@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// ErrStorageUnavailable is returned when no object store is configured.
+var ErrStorageUnavailable = errors.New("user: object storage is not configured")
+
+// avatarUploadTTL bounds how long a presigned upload URL stays valid.
+const avatarUploadTTL = 15 * time.Minute
+
+// maxAvatarSize caps avatar uploads at 2 MiB.
+const maxAvatarSize = 2 << 20
+
+// allowedAvatarTypes lists the image types users may upload.
+var allowedAvatarTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// RequestAvatarUpload validates the declared image and returns an
+// upload ID plus a presigned PUT URL the client uploads directly to,
+// keeping image bytes out of the service.
+func (s *UserService) RequestAvatarUpload(ctx context.Context, callerUserID uuid.UUID, contentType string, sizeBytes int64) (uploadID, uploadURL string, err error) {
+	if s.avatars == nil {
+		return "", "", ErrStorageUnavailable
+	}
+	if !allowedAvatarTypes[contentType] {
+		return "", "", fmt.Errorf("%w: %q", domain.ErrUnsupportedImageType, contentType)
+	}
+	if sizeBytes <= 0 || sizeBytes > maxAvatarSize {
+		return "", "", domain.ErrImageTooLarge
+	}
+
+	uploadID = uuid.NewString()
+	uploadURL, err = s.avatars.PresignPut(avatarKey(callerUserID, uploadID), contentType, avatarUploadTTL)
+	if err != nil {
+		return "", "", fmt.Errorf("user: presign avatar upload: %w", err)
+	}
+	s.log.Info("user: avatar upload requested", "user_id", callerUserID, "upload_id", uploadID)
+	return uploadID, uploadURL, nil
+}
+
+// ConfirmAvatarUpload renders the thumbnail of an uploaded avatar and
+// stores its public URL on the profile. The object key embeds the
+// caller's ID, so one user can never confirm another's upload.
+func (s *UserService) ConfirmAvatarUpload(ctx context.Context, callerUserID uuid.UUID, uploadID string) (*domain.Profile, error) {
+	if s.avatars == nil {
+		return nil, ErrStorageUnavailable
+	}
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return nil, domain.ErrInvalidUpload
+	}
+
+	key := avatarKey(callerUserID, uploadID)
+	// The presigned URL binds the content type but not the body length,
+	// so re-check what actually landed before serving it to anyone.
+	info, err := s.avatars.Stat(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !allowedAvatarTypes[info.ContentType] {
+		return nil, fmt.Errorf("%w: %q", domain.ErrUnsupportedImageType, info.ContentType)
+	}
+	if info.SizeBytes <= 0 || info.SizeBytes > maxAvatarSize {
+		return nil, domain.ErrImageTooLarge
+	}
+
+	// Without a thumbnailer configured the original is served as is —
+	// deployments that resize wire one in.
+	servedKey := key
+	if s.thumbnails != nil {
+		servedKey = key + "_thumb"
+		if err := s.thumbnails.Thumbnail(ctx, key, servedKey); err != nil {
+			return nil, fmt.Errorf("user: render avatar thumbnail: %w", err)
+		}
+	}
+
+	profile, err := s.profiles.SetAvatar(ctx, callerUserID, s.avatarBaseURL+"/"+servedKey)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("user: avatar updated", "user_id", callerUserID)
+	s.publishEvent(ctx, domain.EventProfileUpdated, callerUserID, map[string]string{
+		"fields": "avatar",
+	})
+	return profile, nil
+}
+
+// avatarKey is where a user's avatar upload lands in object storage.
+func avatarKey(userID uuid.UUID, uploadID string) string {
+	return fmt.Sprintf("avatars/%s/%s", userID, uploadID)
+}
+
+// trimBaseURL normalizes the configured public base so joined URLs have
+// exactly one slash.
+func trimBaseURL(base string) string {
+	return strings.TrimRight(base, "/")
+}
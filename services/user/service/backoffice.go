@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Search paging bounds, matching the repo-wide backoffice defaults.
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 100
+)
+
+// clampPageSize bounds a requested page size to [1, maxSearchPageSize],
+// substituting the default for zero.
+func clampPageSize(size int) int {
+	switch {
+	case size <= 0:
+		return defaultSearchPageSize
+	case size > maxSearchPageSize:
+		return maxSearchPageSize
+	default:
+		return size
+	}
+}
+
+// UserSearch is the backoffice search filter.
+type UserSearch struct {
+	Mobile     string
+	NationalID string
+	Name       string
+}
+
+// SearchUsers pages profiles for backoffice. Mobile filters resolve
+// through the auth service first, since the user service does not store
+// mobile numbers.
+func (s *UserService) SearchUsers(ctx context.Context, filter UserSearch, pageSize, page int) ([]*domain.Profile, error) {
+	pageSize = clampPageSize(pageSize)
+	if page < 0 {
+		page = 0
+	}
+
+	if filter.Mobile != "" {
+		if s.audit == nil {
+			return nil, fmt.Errorf("user: mobile search needs the auth directory, which is not configured")
+		}
+		userID, ok, err := s.audit.FindUserByMobile(ctx, filter.Mobile)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, nil
+		}
+		profile, err := s.profiles.GetByUserID(ctx, userID)
+		if err == domain.ErrProfileNotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []*domain.Profile{profile}, nil
+	}
+
+	return s.profiles.Search(ctx, domain.ProfileSearch{
+		NationalID: filter.NationalID,
+		Name:       filter.Name,
+	}, pageSize, page*pageSize)
+}
+
+// UserDetail is the backoffice aggregate for one user.
+type UserDetail struct {
+	Profile    *domain.Profile
+	Logins     *domain.LoginSummary
+	Businesses []domain.BusinessSummary
+}
+
+// GetUserDetail aggregates a user's profile, recent logins, and
+// businesses. Sibling-service sections degrade to absent so one outage
+// doesn't break the whole view.
+func (s *UserService) GetUserDetail(ctx context.Context, userID uuid.UUID) (*UserDetail, error) {
+	profile, err := s.profiles.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	detail := &UserDetail{Profile: profile}
+
+	if s.audit != nil {
+		summary, err := s.audit.UserLoginSummary(ctx, userID)
+		if err != nil {
+			s.log.Warn("user: detail login summary failed", "error", err)
+		} else {
+			detail.Logins = &summary
+		}
+	}
+	if s.businesses != nil {
+		businesses, err := s.businesses.BusinessesOf(ctx, userID)
+		if err != nil {
+			s.log.Warn("user: detail businesses failed", "error", err)
+		} else {
+			detail.Businesses = businesses
+		}
+	}
+	return detail, nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// BlockUser blocks a user with a reason code, records the action in the
+// audit trail, announces it, and revokes the user's sessions. Session
+// revocation is best-effort: the block itself already denies new token
+// issuance through GetUserStatus.
+func (s *UserService) BlockUser(ctx context.Context, actorID, userID uuid.UUID, reason, note string) (*domain.Profile, error) {
+	if !domain.ValidBlockReason(reason) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidBlockReason, reason)
+	}
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if profile.Blocked() {
+		return nil, domain.ErrAlreadyBlocked
+	}
+
+	blocked, err := s.profiles.SetBlocked(ctx, userID, true, reason)
+	if err != nil {
+		return nil, err
+	}
+	s.recordBlockEvent(ctx, userID, actorID, "block", reason, note)
+	s.log.Info("user: blocked", "user_id", userID, "reason", reason, "actor_id", actorID)
+	s.publishEvent(ctx, domain.EventUserBlocked, userID, map[string]string{"reason": reason})
+
+	if s.revoker != nil {
+		if err := s.revoker.RevokeSessions(ctx, userID); err != nil {
+			s.log.Error("user: revoking sessions after block failed", "user_id", userID, "error", err)
+		}
+	}
+	return blocked, nil
+}
+
+// UnblockUser lifts a block, with the same audit and event trail.
+func (s *UserService) UnblockUser(ctx context.Context, actorID, userID uuid.UUID, note string) (*domain.Profile, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !profile.Blocked() {
+		return nil, domain.ErrNotBlocked
+	}
+
+	unblocked, err := s.profiles.SetBlocked(ctx, userID, false, "")
+	if err != nil {
+		return nil, err
+	}
+	s.recordBlockEvent(ctx, userID, actorID, "unblock", "", note)
+	s.log.Info("user: unblocked", "user_id", userID, "actor_id", actorID)
+	s.publishEvent(ctx, domain.EventUserUnblocked, userID, nil)
+	return unblocked, nil
+}
+
+// recordBlockEvent appends to the audit trail; a write failure is
+// logged loudly but does not undo the block itself.
+func (s *UserService) recordBlockEvent(ctx context.Context, userID, actorID uuid.UUID, action, reason, note string) {
+	if s.blockAudit == nil {
+		return
+	}
+	err := s.blockAudit.Record(ctx, &domain.BlockEvent{
+		ID:      uuid.New(),
+		UserID:  userID,
+		Action:  action,
+		Reason:  reason,
+		Note:    note,
+		ActorID: actorID,
+	})
+	if err != nil {
+		s.log.Error("user: recording block audit failed", "user_id", userID, "action", action, "error", err)
+	}
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Dashboard is the aggregate the dashboard screen renders. Logins is
+// nil and Businesses empty when the backing service was unreachable or
+// not configured.
+type Dashboard struct {
+	Profile    *domain.Profile
+	NextKYC    domain.KYCLevel
+	Logins     *domain.LoginSummary
+	Businesses []domain.BusinessSummary
+}
+
+// GetDashboard assembles the caller's dashboard. The profile is the
+// one section that must succeed; sibling-service sections degrade to
+// absent so one outage doesn't blank the whole screen.
+func (s *UserService) GetDashboard(ctx context.Context, callerUserID uuid.UUID) (*Dashboard, error) {
+	profile, err := s.GetProfile(ctx, callerUserID)
+	if err != nil {
+		return nil, err
+	}
+	dashboard := &Dashboard{
+		Profile: profile,
+		NextKYC: profile.KYCLevel.Next(),
+	}
+
+	if s.sessions != nil {
+		summary, err := s.sessions.LoginSummary(ctx)
+		if err != nil {
+			s.log.Warn("user: dashboard login summary failed", "error", err)
+		} else {
+			dashboard.Logins = &summary
+		}
+	}
+	if s.businesses != nil {
+		businesses, err := s.businesses.MyBusinesses(ctx)
+		if err != nil {
+			s.log.Warn("user: dashboard businesses failed", "error", err)
+		} else {
+			dashboard.Businesses = businesses
+		}
+	}
+	return dashboard, nil
+}
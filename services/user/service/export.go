@@ -0,0 +1,278 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// exportUploadTTL bounds the presigned PUT the worker uploads through;
+// exportDownloadTTL bounds the links handed to users.
+const (
+	exportUploadTTL   = 15 * time.Minute
+	exportDownloadTTL = time.Hour
+)
+
+// ExportMyData queues a data export for the caller. An already-running
+// export is returned as is, so retries and double clicks don't pile up
+// archives.
+func (s *UserService) ExportMyData(ctx context.Context, callerUserID uuid.UUID, callerMobile string) (*domain.Export, error) {
+	if s.exports == nil || s.avatars == nil {
+		return nil, ErrStorageUnavailable
+	}
+	active, err := s.exports.GetActiveByUser(ctx, callerUserID)
+	if err == nil {
+		return active, nil
+	}
+	if !errors.Is(err, domain.ErrExportNotFound) {
+		return nil, err
+	}
+
+	export := &domain.Export{
+		ID:     uuid.New(),
+		UserID: callerUserID,
+		Mobile: callerMobile,
+		Status: domain.ExportPending,
+	}
+	if err := s.exports.Create(ctx, export); err != nil {
+		return nil, err
+	}
+	s.log.Info("user: data export requested", "user_id", callerUserID, "export_id", export.ID)
+	return export, nil
+}
+
+// DataExport returns an export's state and, once ready, a time-limited
+// download URL. Non-owners get ErrExportNotFound.
+func (s *UserService) DataExport(ctx context.Context, callerUserID, exportID uuid.UUID) (*domain.Export, string, error) {
+	if s.exports == nil {
+		return nil, "", ErrStorageUnavailable
+	}
+	export, err := s.exports.GetByID(ctx, exportID)
+	if err != nil {
+		return nil, "", err
+	}
+	if export.UserID != callerUserID {
+		return nil, "", domain.ErrExportNotFound
+	}
+	if export.Status != domain.ExportReady {
+		return export, "", nil
+	}
+	url, err := s.avatars.PresignGet(export.ObjectKey, exportDownloadTTL)
+	if err != nil {
+		return nil, "", fmt.Errorf("user: presign export download: %w", err)
+	}
+	return export, url, nil
+}
+
+// exportBundle is the archive schema: one JSON document per export,
+// sectioned by owning service. Sections whose backing service is down
+// are listed in omitted instead of failing the whole export.
+type exportBundle struct {
+	ExportID    string                          `json:"export_id"`
+	UserID      string                          `json:"user_id"`
+	GeneratedAt time.Time                       `json:"generated_at"`
+	Profile     *domain.Profile                 `json:"profile,omitempty"`
+	Addresses   []*domain.Address               `json:"addresses,omitempty"`
+	Settings    domain.Settings                 `json:"settings,omitempty"`
+	BlockEvents []*domain.BlockEvent            `json:"block_events,omitempty"`
+	Logins      *domain.LoginSummary            `json:"logins,omitempty"`
+	Businesses  []domain.BusinessSummary        `json:"businesses,omitempty"`
+	Preferences *domain.NotificationPreferences `json:"notification_preferences,omitempty"`
+	Omitted     []string                        `json:"omitted_sections,omitempty"`
+}
+
+// ExportWorkerConfig tunes the export worker.
+type ExportWorkerConfig struct {
+	// PollInterval is how often an idle worker checks for pending
+	// exports.
+	PollInterval time.Duration
+	// BatchSize bounds exports claimed per poll.
+	BatchSize int
+}
+
+// DefaultExportWorkerConfig is used when the config section is absent.
+func DefaultExportWorkerConfig() ExportWorkerConfig {
+	return ExportWorkerConfig{
+		PollInterval: 10 * time.Second,
+		BatchSize:    5,
+	}
+}
+
+// ExportWorker assembles requested data archives in the background and
+// notifies users when theirs is ready.
+type ExportWorker struct {
+	users *UserService
+	cfg   ExportWorkerConfig
+	http  *http.Client
+	log   *slog.Logger
+}
+
+// NewExportWorker builds the worker.
+func NewExportWorker(users *UserService, cfg ExportWorkerConfig, log *slog.Logger) *ExportWorker {
+	return &ExportWorker{
+		users: users,
+		cfg:   cfg,
+		http:  &http.Client{Timeout: exportUploadTTL},
+		log:   log,
+	}
+}
+
+// Run processes exports until the context is cancelled.
+func (w *ExportWorker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := w.ProcessOnce(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				w.log.Error("user: export worker pass failed", "error", err)
+			}
+		}
+	}
+}
+
+// ProcessOnce claims and processes one batch. Exposed for tests and
+// one-shot CLIs.
+func (w *ExportWorker) ProcessOnce(ctx context.Context) error {
+	batch, err := w.users.exports.ClaimPending(ctx, w.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+	for _, export := range batch {
+		if err := w.process(ctx, export); err != nil {
+			w.log.Error("user: export failed", "export_id", export.ID, "error", err)
+			if markErr := w.users.exports.MarkFailed(ctx, export.ID, err.Error()); markErr != nil {
+				w.log.Error("user: marking export failed", "export_id", export.ID, "error", markErr)
+			}
+		}
+	}
+	return nil
+}
+
+func (w *ExportWorker) process(ctx context.Context, export *domain.Export) error {
+	bundle := w.assemble(ctx, export)
+	payload, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode export: %w", err)
+	}
+
+	key := fmt.Sprintf("exports/%s/%s.json", export.UserID, export.ID)
+	if err := w.upload(ctx, key, payload); err != nil {
+		return err
+	}
+	if err := w.users.exports.MarkReady(ctx, export.ID, key); err != nil {
+		return err
+	}
+	w.log.Info("user: export ready", "export_id", export.ID, "user_id", export.UserID)
+
+	if w.users.exportNotify != nil && export.Mobile != "" {
+		if err := w.users.exportNotify.NotifyExportReady(ctx, export.Mobile); err != nil {
+			w.log.Warn("user: export-ready notification failed", "export_id", export.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+// assemble gathers the user's data from this service and its siblings.
+// Sections whose source fails are omitted and named in the bundle, so
+// a degraded sibling never blocks the export.
+func (w *ExportWorker) assemble(ctx context.Context, export *domain.Export) *exportBundle {
+	bundle := &exportBundle{
+		ExportID:    export.ID.String(),
+		UserID:      export.UserID.String(),
+		GeneratedAt: time.Now().UTC(),
+	}
+	omit := func(section string, err error) {
+		bundle.Omitted = append(bundle.Omitted, section)
+		w.log.Warn("user: export section omitted", "export_id", export.ID, "section", section, "error", err)
+	}
+
+	profile, err := w.users.profiles.GetByUserID(ctx, export.UserID)
+	switch {
+	case err == nil:
+		bundle.Profile = profile
+	case !errors.Is(err, domain.ErrProfileNotFound):
+		omit("profile", err)
+	}
+
+	if addresses, err := w.users.addresses.ListByUser(ctx, export.UserID); err != nil {
+		omit("addresses", err)
+	} else {
+		bundle.Addresses = addresses
+	}
+
+	if w.users.settings != nil {
+		if settings, err := w.users.settings.GetByUserID(ctx, export.UserID); err != nil {
+			omit("settings", err)
+		} else {
+			bundle.Settings = settings.WithDefaults()
+		}
+	}
+
+	if w.users.blockAudit != nil {
+		if events, err := w.users.blockAudit.ListByUser(ctx, export.UserID); err != nil {
+			omit("block_events", err)
+		} else {
+			bundle.BlockEvents = events
+		}
+	}
+
+	if w.users.audit != nil {
+		if logins, err := w.users.audit.UserLoginSummary(ctx, export.UserID); err != nil {
+			omit("logins", err)
+		} else {
+			bundle.Logins = &logins
+		}
+	}
+
+	if w.users.businesses != nil {
+		if businesses, err := w.users.businesses.BusinessesOf(ctx, export.UserID); err != nil {
+			omit("businesses", err)
+		} else {
+			bundle.Businesses = businesses
+		}
+	}
+
+	if w.users.prefDir != nil && export.Mobile != "" {
+		if prefs, err := w.users.prefDir.PreferencesOf(ctx, export.Mobile); err != nil {
+			omit("notification_preferences", err)
+		} else {
+			bundle.Preferences = prefs
+		}
+	}
+	return bundle
+}
+
+// upload PUTs the archive through a presigned URL, so the storage
+// adapter stays SDK-free.
+func (w *ExportWorker) upload(ctx context.Context, key string, payload []byte) error {
+	url, err := w.users.avatars.PresignPut(key, "application/json", exportUploadTTL)
+	if err != nil {
+		return fmt.Errorf("presign export upload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build export upload: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("upload export: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("upload export: storage returned %d", resp.StatusCode)
+	}
+	return nil
+}
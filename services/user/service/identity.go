@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// VerifyIdentity matches the caller's name and birthdate against the
+// civil registry record for their national ID and stamps the profile on
+// success. A mismatch is definitive — the user must fix the profile and
+// try again.
+func (s *UserService) VerifyIdentity(ctx context.Context, callerUserID uuid.UUID) (*domain.Profile, error) {
+	profile, err := s.profiles.GetByUserID(ctx, callerUserID)
+	if err != nil {
+		return nil, err
+	}
+	if profile.IdentityVerified() {
+		return profile, nil
+	}
+	if profile.NationalID == "" || profile.FirstName == "" || profile.LastName == "" || profile.BirthDate == nil {
+		return nil, domain.ErrKYCProfileIncomplete
+	}
+	if s.identity == nil {
+		return nil, domain.ErrSabteahvalUnavailable
+	}
+	if err := s.identity.VerifyIdentity(ctx, profile.NationalID, profile.FirstName, profile.LastName, *profile.BirthDate); err != nil {
+		return nil, err
+	}
+	verified, err := s.profiles.SetIdentityVerified(ctx, callerUserID, true)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("user: identity verified", "user_id", callerUserID)
+	s.publishEvent(ctx, domain.EventIdentityVerified, callerUserID, nil)
+	return verified, nil
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// KYCStatus returns the caller's level and the level one step above it.
+func (s *UserService) KYCStatus(ctx context.Context, callerUserID uuid.UUID) (domain.KYCLevel, domain.KYCLevel, error) {
+	profile, err := s.GetProfile(ctx, callerUserID)
+	if err != nil {
+		return "", "", err
+	}
+	return profile.KYCLevel, profile.KYCLevel.Next(), nil
+}
+
+// AdvanceKYC moves the caller one level up when the next level's checks
+// pass. Mobile verification is implied by the OTP login; the Shahkar
+// step matches the login mobile against the profile's national ID. Full
+// KYC is never reached here — backoffice grants it after document
+// review.
+func (s *UserService) AdvanceKYC(ctx context.Context, callerUserID uuid.UUID, callerMobile string) (domain.KYCLevel, error) {
+	profile, err := s.GetProfile(ctx, callerUserID)
+	if err != nil {
+		return "", err
+	}
+
+	switch profile.KYCLevel {
+	case domain.KYCNone:
+		return s.setLevel(ctx, callerUserID, profile.KYCLevel, domain.KYCMobileVerified)
+	case domain.KYCMobileVerified:
+		if profile.NationalID == "" {
+			return "", domain.ErrKYCProfileIncomplete
+		}
+		if s.shahkar == nil {
+			return "", domain.ErrShahkarUnavailable
+		}
+		if err := s.shahkar.VerifyMobileOwnership(ctx, profile.NationalID, callerMobile); err != nil {
+			return "", err
+		}
+		return s.setLevel(ctx, callerUserID, profile.KYCLevel, domain.KYCShahkarVerified)
+	case domain.KYCShahkarVerified:
+		return "", domain.ErrKYCManualReview
+	default:
+		return "", domain.ErrKYCAtTop
+	}
+}
+
+// ApproveFullKYC grants the top level after manual document review.
+// Authorization (backoffice only) is the handler's job.
+func (s *UserService) ApproveFullKYC(ctx context.Context, approvedBy, userID uuid.UUID) (domain.KYCLevel, error) {
+	profile, err := s.GetProfile(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if !profile.KYCLevel.AtLeast(domain.KYCShahkarVerified) {
+		return "", domain.ErrKYCNotReady
+	}
+	if !profile.IdentityVerified() {
+		return "", domain.ErrIdentityNotVerified
+	}
+	if profile.KYCLevel == domain.KYCFull {
+		return profile.KYCLevel, nil
+	}
+	level, err := s.setLevel(ctx, userID, profile.KYCLevel, domain.KYCFull)
+	if err != nil {
+		return "", err
+	}
+	s.log.Info("user: full kyc approved", "user_id", userID, "approved_by", approvedBy)
+	return level, nil
+}
+
+// setLevel persists the transition and announces it.
+func (s *UserService) setLevel(ctx context.Context, userID uuid.UUID, from, to domain.KYCLevel) (domain.KYCLevel, error) {
+	profile, err := s.profiles.SetKYCLevel(ctx, userID, to)
+	if err != nil {
+		return "", err
+	}
+	s.log.Info("user: kyc level changed", "user_id", userID, "from", from, "to", to)
+	s.publishEvent(ctx, domain.EventKYCLevelChanged, userID, map[string]string{
+		"from": string(from),
+		"to":   string(to),
+	})
+	return profile.KYCLevel, nil
+}
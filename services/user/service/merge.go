@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// FindDuplicateUsers returns groups of accounts that look like the same
+// person, for backoffice merge review.
+func (s *UserService) FindDuplicateUsers(ctx context.Context, limit int) ([]domain.DuplicateGroup, error) {
+	return s.profiles.FindDuplicates(ctx, clampPageSize(limit))
+}
+
+// MergeUsers folds a duplicate account into the surviving one: profile
+// fields the survivor is missing are copied over, businesses are
+// reassigned through the customer service, the duplicate is blocked
+// with its sessions revoked, and the whole operation is audited. The
+// decision which account survives stays with the operator.
+func (s *UserService) MergeUsers(ctx context.Context, actorID, survivorID, duplicateID uuid.UUID, note string) (*domain.MergeRecord, error) {
+	if survivorID == duplicateID {
+		return nil, domain.ErrMergeSameUser
+	}
+	if s.reassigner == nil {
+		return nil, fmt.Errorf("user: merging needs the customer reassignment client, which is not configured")
+	}
+	survivor, err := s.profiles.GetByUserID(ctx, survivorID)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := s.profiles.GetByUserID(ctx, duplicateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.adoptProfileFields(ctx, survivor, duplicate); err != nil {
+		return nil, err
+	}
+
+	moved, err := s.reassigner.ReassignBusinesses(ctx, duplicateID, survivorID)
+	if err != nil {
+		return nil, fmt.Errorf("user: reassigning businesses: %w", err)
+	}
+
+	// Blocking revokes the duplicate's sessions and keeps the account
+	// out of every flow; an already-blocked duplicate stays blocked.
+	_, err = s.BlockUser(ctx, actorID, duplicateID, domain.BlockReasonMerged,
+		"merged into "+survivorID.String())
+	if err != nil && !errors.Is(err, domain.ErrAlreadyBlocked) {
+		return nil, err
+	}
+
+	record := &domain.MergeRecord{
+		ID:              uuid.New(),
+		SurvivorID:      survivorID,
+		DuplicateID:     duplicateID,
+		ActorID:         actorID,
+		Note:            note,
+		BusinessesMoved: moved,
+	}
+	if s.mergeAudit != nil {
+		if err := s.mergeAudit.Record(ctx, record); err != nil {
+			s.log.Error("user: recording merge audit failed",
+				"survivor_id", survivorID, "duplicate_id", duplicateID, "error", err)
+		}
+	}
+	s.log.Info("user: accounts merged",
+		"survivor_id", survivorID, "duplicate_id", duplicateID,
+		"businesses_moved", moved, "actor_id", actorID)
+	s.publishEvent(ctx, domain.EventUsersMerged, survivorID, map[string]string{
+		"duplicate_id":     duplicateID.String(),
+		"businesses_moved": fmt.Sprintf("%d", moved),
+	})
+	return record, nil
+}
+
+// adoptProfileFields copies fields the survivor is missing from the
+// duplicate. The duplicate's national ID is cleared first so the unique
+// index never sees it on two rows.
+func (s *UserService) adoptProfileFields(ctx context.Context, survivor, duplicate *domain.Profile) error {
+	adoptNationalID := survivor.NationalID == "" && duplicate.NationalID != ""
+	if adoptNationalID {
+		cleared := *duplicate
+		cleared.NationalID = ""
+		if _, err := s.profiles.Upsert(ctx, &cleared); err != nil {
+			return err
+		}
+		survivor.NationalID = duplicate.NationalID
+	}
+
+	changed := adoptNationalID
+	if survivor.FirstName == "" && duplicate.FirstName != "" {
+		survivor.FirstName = duplicate.FirstName
+		changed = true
+	}
+	if survivor.LastName == "" && duplicate.LastName != "" {
+		survivor.LastName = duplicate.LastName
+		changed = true
+	}
+	if survivor.BirthDate == nil && duplicate.BirthDate != nil {
+		survivor.BirthDate = duplicate.BirthDate
+		changed = true
+	}
+	if survivor.Email == "" && duplicate.Email != "" {
+		survivor.Email = duplicate.Email
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	_, err := s.profiles.Upsert(ctx, survivor)
+	return err
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// GetSettings returns the caller's effective settings: saved values
+// overlaid on the per-key defaults.
+func (s *UserService) GetSettings(ctx context.Context, callerUserID uuid.UUID) (domain.Settings, error) {
+	settings, err := s.settings.GetByUserID(ctx, callerUserID)
+	if err != nil {
+		return nil, err
+	}
+	return settings.WithDefaults(), nil
+}
+
+// UpdateSettings writes the given keys after validating them, leaving
+// the rest untouched. A language change is pushed to the notification
+// service best-effort so message rendering follows it.
+func (s *UserService) UpdateSettings(ctx context.Context, callerUserID uuid.UUID, callerMobile string, values map[string]string) (domain.Settings, error) {
+	if len(values) == 0 {
+		return s.GetSettings(ctx, callerUserID)
+	}
+	for key, value := range values {
+		if err := domain.ValidateSetting(key, value); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, key)
+		}
+	}
+	if err := s.settings.Upsert(ctx, callerUserID, values); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	s.log.Info("user: settings updated", "user_id", callerUserID, "keys", keys)
+	s.publishEvent(ctx, domain.EventSettingsUpdated, callerUserID, map[string]string{
+		"keys": strings.Join(keys, ","),
+	})
+
+	if locale, ok := values[domain.SettingLanguage]; ok && s.localeSync != nil && callerMobile != "" {
+		if err := s.localeSync.SyncLocale(ctx, callerMobile, locale); err != nil {
+			s.log.Warn("user: syncing locale to notification failed", "user_id", callerUserID, "error", err)
+		}
+	}
+	return s.GetSettings(ctx, callerUserID)
+}
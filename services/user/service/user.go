@@ -0,0 +1,208 @@
+// Package service implements the user service's use cases.
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// UserService manages user profiles, addresses, and KYC state.
+type UserService struct {
+	profiles   domain.ProfileRepository
+	addresses  domain.AddressRepository
+	shahkar    domain.MobileOwnershipVerifier
+	identity   domain.IdentityVerifier
+	postal     domain.PostalLookup
+	events     domain.EventPublisher
+	sessions   domain.SessionDirectory
+	businesses domain.BusinessDirectory
+	audit      domain.LoginAuditDirectory
+	blockAudit domain.BlockAuditRepository
+	revoker    domain.SessionRevoker
+	settings   domain.SettingsRepository
+	localeSync domain.LocaleSyncer
+	avatars    domain.ObjectStore
+	thumbnails domain.Thumbnailer
+	// avatarBaseURL is the CDN (or storage) base public avatar URLs are
+	// built on.
+	avatarBaseURL string
+	exports       domain.ExportRepository
+	prefDir       domain.PreferenceDirectory
+	exportNotify  domain.ExportNotifier
+	reassigner    domain.BusinessReassigner
+	mergeAudit    domain.MergeAuditRepository
+	log           *slog.Logger
+}
+
+// Deps bundles the service's collaborators; optional ones (shahkar,
+// postal, events) may be nil, which disables the feature they back
+// rather than the whole service.
+type Deps struct {
+	Profiles  domain.ProfileRepository
+	Addresses domain.AddressRepository
+	Shahkar   domain.MobileOwnershipVerifier
+	Identity  domain.IdentityVerifier
+	Postal    domain.PostalLookup
+	Events    domain.EventPublisher
+	// Sessions and Businesses feed the dashboard aggregate; nil omits
+	// the section. Audit backs the backoffice views.
+	Sessions   domain.SessionDirectory
+	Businesses domain.BusinessDirectory
+	Audit      domain.LoginAuditDirectory
+	// BlockAudit and Revoker back the block/unblock risk actions.
+	BlockAudit domain.BlockAuditRepository
+	Revoker    domain.SessionRevoker
+	// Settings backs the preferences store; LocaleSync (optional)
+	// mirrors language changes into notification preferences.
+	Settings   domain.SettingsRepository
+	LocaleSync domain.LocaleSyncer
+	// Avatars, Thumbnails, and AvatarBaseURL back avatar uploads;
+	// without a store the feature is disabled.
+	Avatars       domain.ObjectStore
+	Thumbnails    domain.Thumbnailer
+	AvatarBaseURL string
+	// Exports backs data exports; Prefs and ExportNotify (optional) add
+	// the notification sections and the export-ready SMS.
+	Exports      domain.ExportRepository
+	Prefs        domain.PreferenceDirectory
+	ExportNotify domain.ExportNotifier
+	// Reassigner and MergeAudit back the backoffice account merge.
+	Reassigner domain.BusinessReassigner
+	MergeAudit domain.MergeAuditRepository
+}
+
+// NewUserService builds the service.
+func NewUserService(deps Deps, log *slog.Logger) *UserService {
+	return &UserService{
+		profiles:      deps.Profiles,
+		addresses:     deps.Addresses,
+		shahkar:       deps.Shahkar,
+		identity:      deps.Identity,
+		postal:        deps.Postal,
+		events:        deps.Events,
+		sessions:      deps.Sessions,
+		businesses:    deps.Businesses,
+		audit:         deps.Audit,
+		blockAudit:    deps.BlockAudit,
+		revoker:       deps.Revoker,
+		settings:      deps.Settings,
+		localeSync:    deps.LocaleSync,
+		avatars:       deps.Avatars,
+		thumbnails:    deps.Thumbnails,
+		avatarBaseURL: trimBaseURL(deps.AvatarBaseURL),
+		exports:       deps.Exports,
+		prefDir:       deps.Prefs,
+		exportNotify:  deps.ExportNotify,
+		reassigner:    deps.Reassigner,
+		mergeAudit:    deps.MergeAudit,
+		log:           log,
+	}
+}
+
+// publishEvent hands a user event to the outbox. Event loss is logged
+// but never fails the domain write that triggered it.
+func (s *UserService) publishEvent(ctx context.Context, name string, userID uuid.UUID, payload map[string]string) {
+	if s.events == nil {
+		return
+	}
+	err := s.events.Publish(ctx, domain.Event{
+		Name:       name,
+		UserID:     userID,
+		OccurredAt: time.Now(),
+		Payload:    payload,
+	})
+	if err != nil {
+		s.log.Error("user: publishing event failed", "event", name, "error", err)
+	}
+}
+
+// GetProfile returns the caller's profile. A user who never saved one
+// gets an empty profile rather than an error, so clients don't have to
+// special-case first visits.
+func (s *UserService) GetProfile(ctx context.Context, callerUserID uuid.UUID) (*domain.Profile, error) {
+	profile, err := s.profiles.GetByUserID(ctx, callerUserID)
+	if err == domain.ErrProfileNotFound {
+		return &domain.Profile{UserID: callerUserID, KYCLevel: domain.KYCNone}, nil
+	}
+	return profile, err
+}
+
+// UserStatus returns the profile backing the service-to-service
+// standing check. Unlike GetProfile it does not synthesize empty
+// profiles: a user the service has never seen is unknown.
+func (s *UserService) UserStatus(ctx context.Context, userID uuid.UUID) (*domain.Profile, error) {
+	return s.profiles.GetByUserID(ctx, userID)
+}
+
+// ProfileUpdate carries the fields UpdateProfile may change; nil
+// pointers mean "leave unchanged".
+type ProfileUpdate struct {
+	FirstName  *string
+	LastName   *string
+	NationalID *string
+	BirthDate  *time.Time
+	Email      *string
+}
+
+// UpdateProfile applies a partial update to the caller's profile,
+// creating it on first write. Only the user the profile belongs to can
+// reach this path; ownership is fixed by keying on the caller's ID.
+func (s *UserService) UpdateProfile(ctx context.Context, callerUserID uuid.UUID, update ProfileUpdate) (*domain.Profile, error) {
+	profile, err := s.profiles.GetByUserID(ctx, callerUserID)
+	if err == domain.ErrProfileNotFound {
+		profile = &domain.Profile{UserID: callerUserID, KYCLevel: domain.KYCNone}
+	} else if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	if update.FirstName != nil {
+		profile.FirstName = *update.FirstName
+		changed = append(changed, "first_name")
+	}
+	if update.LastName != nil {
+		profile.LastName = *update.LastName
+		changed = append(changed, "last_name")
+	}
+	if update.NationalID != nil {
+		if *update.NationalID != "" && !domain.ValidateNationalCode(*update.NationalID) {
+			return nil, fmt.Errorf("%w: %q", domain.ErrInvalidNationalID, *update.NationalID)
+		}
+		profile.NationalID = *update.NationalID
+		changed = append(changed, "national_id")
+	}
+	if update.BirthDate != nil {
+		if !domain.ValidateBirthDate(*update.BirthDate) {
+			return nil, domain.ErrInvalidBirthDate
+		}
+		profile.BirthDate = update.BirthDate
+		changed = append(changed, "birth_date")
+	}
+	if update.Email != nil {
+		if *update.Email != "" && !domain.ValidateEmail(*update.Email) {
+			return nil, fmt.Errorf("%w: %q", domain.ErrInvalidEmail, *update.Email)
+		}
+		profile.Email = *update.Email
+		changed = append(changed, "email")
+	}
+
+	updated, err := s.profiles.Upsert(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	s.log.Info("user: profile updated", "user_id", callerUserID)
+	// The payload names what changed, not the values: consumers that
+	// care (customer's cached standing, notification) re-read through
+	// the API instead of trusting broker payloads with PII.
+	s.publishEvent(ctx, domain.EventProfileUpdated, callerUserID, map[string]string{
+		"fields": strings.Join(changed, ","),
+	})
+	return updated, nil
+}
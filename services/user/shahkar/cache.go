@@ -0,0 +1,89 @@
+package shahkar
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Cache TTL defaults. A confirmed match is stable (numbers rarely change
+// owners), so it may live long; a mismatch is re-checked sooner because
+// the user may be fixing their registration.
+const (
+	DefaultMatchTTL    = 24 * time.Hour
+	DefaultMismatchTTL = time.Hour
+)
+
+// Cached value markers.
+const (
+	cachedMatch    = "1"
+	cachedMismatch = "0"
+)
+
+// CacheConfig tunes the cached verifier; zero TTLs take the defaults.
+type CacheConfig struct {
+	MatchTTL    time.Duration
+	MismatchTTL time.Duration
+}
+
+// CachedVerifier decorates a MobileOwnershipVerifier with a Redis cache
+// so repeated KYC checks for the same pair don't each cost a paid
+// Shahkar call. Only deterministic outcomes (match, mismatch) are
+// cached; transient failures always fall through to the inner verifier.
+type CachedVerifier struct {
+	inner       domain.MobileOwnershipVerifier
+	redis       redis.UniversalClient
+	matchTTL    time.Duration
+	mismatchTTL time.Duration
+}
+
+// NewCachedVerifier wraps inner with a Redis-backed result cache.
+func NewCachedVerifier(inner domain.MobileOwnershipVerifier, client redis.UniversalClient, cfg CacheConfig) *CachedVerifier {
+	if cfg.MatchTTL <= 0 {
+		cfg.MatchTTL = DefaultMatchTTL
+	}
+	if cfg.MismatchTTL <= 0 {
+		cfg.MismatchTTL = DefaultMismatchTTL
+	}
+	return &CachedVerifier{
+		inner:       inner,
+		redis:       client,
+		matchTTL:    cfg.MatchTTL,
+		mismatchTTL: cfg.MismatchTTL,
+	}
+}
+
+// cacheKey hashes the pair so national IDs never appear verbatim in
+// Redis keys (they would otherwise leak into monitoring and slowlogs).
+func cacheKey(nationalID, mobile string) string {
+	sum := sha256.Sum256([]byte(nationalID + "|" + mobile))
+	return "user:shahkar:" + hex.EncodeToString(sum[:])
+}
+
+// VerifyMobileOwnership answers from the cache when possible. Cache
+// errors are ignored — the verifier degrades to uncached, never fails a
+// KYC check because Redis is down.
+func (v *CachedVerifier) VerifyMobileOwnership(ctx context.Context, nationalID, mobile string) error {
+	key := cacheKey(nationalID, mobile)
+	if cached, err := v.redis.Get(ctx, key).Result(); err == nil {
+		if cached == cachedMatch {
+			return nil
+		}
+		return domain.ErrMobileNotOwned
+	}
+
+	err := v.inner.VerifyMobileOwnership(ctx, nationalID, mobile)
+	switch {
+	case err == nil:
+		v.redis.Set(ctx, key, cachedMatch, v.matchTTL)
+	case errors.Is(err, domain.ErrMobileNotOwned):
+		v.redis.Set(ctx, key, cachedMismatch, v.mismatchTTL)
+	}
+	return err
+}
@@ -0,0 +1,246 @@
+// Package shahkar queries the national Shahkar registry to verify that
+// a mobile number is registered to a national ID.
+package shahkar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/K-H-Tech/infera/pkg/common"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// Config configures the Shahkar client.
+type Config struct {
+	BaseURL string
+	// ClientID and ClientSecret authenticate against the provider's
+	// token endpoint.
+	ClientID     string
+	ClientSecret string
+	Timeout      time.Duration
+	// Retries is how many times a 5xx or network failure is retried.
+	// Zero means DefaultRetries.
+	Retries int
+}
+
+// Default client tuning.
+const (
+	DefaultRetries = 2
+	retryBaseDelay = 500 * time.Millisecond
+	// tokenSlack refreshes the access token slightly before the provider
+	// expires it, so in-flight inquiries never race the expiry.
+	tokenSlack = 30 * time.Second
+)
+
+// Shahkar result codes, from the provider's service-ID matching spec.
+const (
+	resultMatched      = 200
+	resultNotMatched   = 600
+	resultInvalidInput = 310
+)
+
+// serviceTypeMobile identifies a mobile-number inquiry (as opposed to
+// landline or other service kinds Shahkar supports).
+const serviceTypeMobile = 2
+
+// Client talks to Shahkar through the provider gateway. It implements
+// domain.MobileOwnershipVerifier.
+type Client struct {
+	http         *common.HTTPClient
+	clientID     string
+	clientSecret string
+	retries      int
+
+	mu          sync.Mutex
+	accessToken string
+	tokenExpiry time.Time
+}
+
+// New builds a Shahkar client.
+func New(cfg Config) *Client {
+	if cfg.Retries <= 0 {
+		cfg.Retries = DefaultRetries
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	return &Client{
+		http: common.NewHTTPClient(
+			common.WithBaseURL(cfg.BaseURL),
+			common.WithClientTimeout(cfg.Timeout),
+		),
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+		retries:      cfg.Retries,
+	}
+}
+
+// inquiryRequest is the service-ID matching request schema.
+type inquiryRequest struct {
+	NationalID  string `json:"identificationNo"`
+	Mobile      string `json:"serviceNumber"`
+	ServiceType int    `json:"serviceType"`
+}
+
+// inquiryResponse is the provider's answer. ResultCode carries the
+// Shahkar outcome even when the HTTP status is 200.
+type inquiryResponse struct {
+	ResultCode int    `json:"resultCode"`
+	Matched    bool   `json:"matched"`
+	Comment    string `json:"comment"`
+}
+
+// VerifyMobileOwnership checks the (national ID, mobile) pair against
+// Shahkar. 5xx and transport failures are retried with backoff; a 401
+// invalidates the cached token so the retry re-authenticates.
+func (c *Client) VerifyMobileOwnership(ctx context.Context, nationalID, mobile string) error {
+	payload, err := json.Marshal(inquiryRequest{
+		NationalID:  nationalID,
+		Mobile:      mobile,
+		ServiceType: serviceTypeMobile,
+	})
+	if err != nil {
+		return fmt.Errorf("shahkar: encode inquiry: %w", err)
+	}
+
+	var body []byte
+	for attempt := 0; ; attempt++ {
+		body, err = c.inquire(ctx, payload)
+		if err == nil {
+			break
+		}
+		if !c.retryable(err) || attempt >= c.retries || ctx.Err() != nil {
+			return fmt.Errorf("%w: %v", domain.ErrShahkarUnavailable, err)
+		}
+		select {
+		case <-time.After(retryBaseDelay << attempt):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	var result inquiryResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("shahkar: decode response: %w", err)
+	}
+	switch result.ResultCode {
+	case resultMatched:
+		if !result.Matched {
+			return domain.ErrMobileNotOwned
+		}
+		return nil
+	case resultNotMatched:
+		return domain.ErrMobileNotOwned
+	case resultInvalidInput:
+		return fmt.Errorf("%w: %s", domain.ErrShahkarRejected, result.Comment)
+	default:
+		return fmt.Errorf("%w: result code %d: %s", domain.ErrShahkarUnavailable, result.ResultCode, result.Comment)
+	}
+}
+
+// retryableError marks failures the inquiry loop may retry (network
+// errors, 5xx, expired tokens).
+type retryableError struct{ err error }
+
+func (e retryableError) Error() string { return e.err.Error() }
+func (e retryableError) Unwrap() error { return e.err }
+
+func (c *Client) retryable(err error) bool {
+	_, ok := err.(retryableError)
+	return ok
+}
+
+// inquire performs one authenticated matching call.
+func (c *Client) inquire(ctx context.Context, payload []byte) ([]byte, error) {
+	token, err := c.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.http.Post(ctx, "/api/v2/service-id-matching", "application/json",
+		bytes.NewReader(payload), common.WithHeader("Authorization", "Bearer "+token))
+	if err != nil {
+		return nil, retryableError{err}
+	}
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		// The token was revoked server-side; drop it and retry fresh.
+		_, _ = common.ReadResponseBody(resp)
+		c.invalidateToken(token)
+		return nil, retryableError{fmt.Errorf("token rejected")}
+	case resp.StatusCode >= 500:
+		_, _ = common.ReadResponseBody(resp)
+		return nil, retryableError{fmt.Errorf("status %d", resp.StatusCode)}
+	}
+	body, err := common.ReadResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: status %d", domain.ErrShahkarRejected, resp.StatusCode)
+	}
+	return body, nil
+}
+
+// tokenResponse is the token endpoint's answer.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// token returns a cached access token, fetching a new one when the
+// cached token is missing or about to expire.
+func (c *Client) token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	if c.accessToken != "" && time.Now().Before(c.tokenExpiry.Add(-tokenSlack)) {
+		token := c.accessToken
+		c.mu.Unlock()
+		return token, nil
+	}
+	c.mu.Unlock()
+
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+	}
+	resp, err := c.http.Post(ctx, "/oauth/token", "application/x-www-form-urlencoded",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", retryableError{err}
+	}
+	if resp.StatusCode >= 500 {
+		_, _ = common.ReadResponseBody(resp)
+		return "", retryableError{fmt.Errorf("token endpoint status %d", resp.StatusCode)}
+	}
+	body, err := common.ReadResponseBody(resp)
+	if err != nil {
+		return "", fmt.Errorf("shahkar: fetch token: %w", err)
+	}
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("shahkar: decode token: %w", err)
+	}
+	if token.AccessToken == "" || token.ExpiresIn <= 0 {
+		return "", fmt.Errorf("shahkar: token endpoint returned no usable token")
+	}
+
+	c.mu.Lock()
+	c.accessToken = token.AccessToken
+	c.tokenExpiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	c.mu.Unlock()
+	return token.AccessToken, nil
+}
+
+// invalidateToken forgets the cached token if it is still the one the
+// server rejected; a concurrent refresh is left alone.
+func (c *Client) invalidateToken(rejected string) {
+	c.mu.Lock()
+	if c.accessToken == rejected {
+		c.accessToken = ""
+	}
+	c.mu.Unlock()
+}
@@ -0,0 +1,12 @@
+package shahkar
+
+import "context"
+
+// Stub is a MobileOwnershipVerifier for environments without Shahkar
+// access (local development, CI). Every pair matches.
+type Stub struct{}
+
+// VerifyMobileOwnership always reports a match.
+func (Stub) VerifyMobileOwnership(ctx context.Context, nationalID, mobile string) error {
+	return nil
+}
@@ -0,0 +1,48 @@
+// Package storage holds the user service's object-storage adapters.
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/K-H-Tech/infera/pkg/sigv4"
+	"github.com/K-H-Tech/infera/services/user/domain"
+)
+
+// S3Config configures the S3/MinIO presigner.
+type S3Config = sigv4.Config
+
+// S3 adapts the shared SigV4 presigner to domain.ObjectStore.
+type S3 struct {
+	presigner *sigv4.Presigner
+}
+
+// NewS3 builds the adapter.
+func NewS3(cfg S3Config) *S3 {
+	return &S3{presigner: sigv4.New(cfg)}
+}
+
+// PresignPut returns a URL that allows exactly one kind of request: a
+// PUT of the object at key with the given Content-Type, valid for
+// expiry.
+func (s *S3) PresignPut(key, contentType string, expiry time.Duration) (string, error) {
+	return s.presigner.PresignPut(key, contentType, expiry)
+}
+
+// PresignGet returns a time-limited download URL for the object at key.
+func (s *S3) PresignGet(key string, expiry time.Duration) (string, error) {
+	return s.presigner.PresignGet(key, expiry)
+}
+
+// Stat reports what actually landed in the bucket under key.
+func (s *S3) Stat(ctx context.Context, key string) (domain.ObjectInfo, error) {
+	info, err := s.presigner.Stat(ctx, key)
+	if errors.Is(err, sigv4.ErrObjectNotFound) {
+		return domain.ObjectInfo{}, domain.ErrInvalidUpload
+	}
+	if err != nil {
+		return domain.ObjectInfo{}, err
+	}
+	return domain.ObjectInfo{ContentType: info.ContentType, SizeBytes: info.SizeBytes}, nil
+}